@@ -4,8 +4,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
 
+	globalfeekeeper "github.com/irisnet/irishub/modules/globalfee/keeper"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	msgfilterkeeper "github.com/irisnet/irishub/modules/msgfilter/keeper"
+	txfeeskeeper "github.com/irisnet/irishub/modules/txfees/keeper"
 
 	oraclekeeper "github.com/irisnet/irismod/modules/oracle/keeper"
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
@@ -19,7 +24,15 @@ type HandlerOptions struct {
 	TokenKeeper          tokenkeeper.Keeper
 	OracleKeeper         oraclekeeper.Keeper
 	GuardianKeeper       guardiankeeper.Keeper
+	TxFeesKeeper         txfeeskeeper.Keeper
+	GlobalFeeKeeper      globalfeekeeper.Keeper
+	MsgFilterKeeper      msgfilterkeeper.Keeper
+	GroupKeeper          groupkeeper.Keeper
 	BypassMinFeeMsgTypes []string
+
+	// MaxBypassMinFeeMsgGasUsage is the max gas of a tx bypassing the min fee,
+	// DefaultMaxBypassMinFeeMsgGasUsage if zero
+	MaxBypassMinFeeMsgGasUsage uint64
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -34,17 +47,19 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewExtensionOptionsDecorator(opts.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
+		NewMsgFilterDecorator(opts.MsgFilterKeeper, opts.GroupKeeper),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
+		NewMinFeeDecorator(opts.BypassMinFeeMsgTypes, opts.MaxBypassMinFeeMsgGasUsage, opts.TxFeesKeeper, opts.GlobalFeeKeeper), // MinFeeDecorator must be called before DeductFeeDecorator
 		ante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, opts.TxFeeChecker),
 		ante.NewSetPubKeyDecorator(opts.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(opts.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(opts.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(opts.AccountKeeper, opts.SignModeHandler),
-		NewValidateTokenDecorator(opts.TokenKeeper),
+		NewValidateTokenDecorator(opts.TokenKeeper, opts.GroupKeeper),
 		tokenkeeper.NewValidateTokenFeeDecorator(opts.TokenKeeper, opts.BankKeeper),
-		oraclekeeper.NewValidateOracleAuthDecorator(opts.OracleKeeper, opts.GuardianKeeper),
+		oraclekeeper.NewValidateOracleAuthDecorator(opts.OracleKeeper, opts.GuardianKeeper.NewRoleAuthorizer(guardiantypes.RoleOracleOperator)),
 		NewValidateServiceDecorator(),
 		ante.NewIncrementSequenceDecorator(opts.AccountKeeper),
 	), nil
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 
 	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
@@ -14,41 +15,67 @@ import (
 	tokentypes "github.com/irisnet/irismod/modules/token/types"
 )
 
-// ValidateTokenDecorator is responsible for restricting the token participation of the swap prefix
+// ValidateTokenDecorator is responsible for restricting the token participation of the swap prefix,
+// including the messages nested in authz MsgExec and group proposals on both submission and execution
 type ValidateTokenDecorator struct {
 	tk tokenkeeper.Keeper
+	gk GroupKeeper
 }
 
 // NewValidateTokenDecorator returns an instance of ValidateTokenDecorator
-func NewValidateTokenDecorator(tk tokenkeeper.Keeper) ValidateTokenDecorator {
+func NewValidateTokenDecorator(tk tokenkeeper.Keeper, gk GroupKeeper) ValidateTokenDecorator {
 	return ValidateTokenDecorator{
 		tk: tk,
+		gk: gk,
 	}
 }
 
 // AnteHandle checks the transaction
 func (vtd ValidateTokenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	for _, msg := range tx.GetMsgs() {
+	if err := vtd.validateMsgs(ctx, tx.GetMsgs(), 0); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// validateMsgs checks the msgs and the msgs nested in them, where depth is the depth of the msgs
+func (vtd ValidateTokenDecorator) validateMsgs(ctx sdk.Context, msgs []sdk.Msg, depth int) error {
+	for _, msg := range msgs {
 		switch msg := msg.(type) {
 		case *ibctransfertypes.MsgTransfer:
 			if containSwapCoin(msg.Token) {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't transfer coinswap liquidity tokens through the IBC module")
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't transfer coinswap liquidity tokens through the IBC module")
 			}
 		case *tokentypes.MsgBurnToken:
 			if _, err := vtd.tk.GetToken(ctx, msg.Symbol); err != nil {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "burnt failed, only native tokens can be burnt")
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "burnt failed, only native tokens can be burnt")
 			}
 		case *govv1.MsgSubmitProposal:
 			if containSwapCoin(msg.InitialDeposit...) {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't deposit coinswap liquidity token for proposal")
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't deposit coinswap liquidity token for proposal")
 			}
 		case *govv1.MsgDeposit:
 			if containSwapCoin(msg.Amount...) {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't deposit coinswap liquidity token for proposal")
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't deposit coinswap liquidity token for proposal")
 			}
 		}
+
+		nested, err := getNestedMsgs(msg, depth)
+		if err != nil {
+			return err
+		}
+		if msg, ok := msg.(*group.MsgExec); ok {
+			// the msgs of the proposal are only run on its execution, and may not have passed the ante
+			// handler on submission, e.g. submitted before the upgrade or through an interchain account
+			if nested, err = getProposalMsgs(ctx, vtd.gk, msg.ProposalId, depth); err != nil {
+				return err
+			}
+		}
+		if err := vtd.validateMsgs(ctx, nested, depth+1); err != nil {
+			return err
+		}
 	}
-	return next(ctx, tx, simulate)
+	return nil
 }
 
 // ValidateServiceDecorator is responsible for checking the permission to execute MsgCallService
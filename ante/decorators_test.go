@@ -0,0 +1,101 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	"github.com/irisnet/irishub/simapp"
+)
+
+func TestValidateTokenDecorator(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	tokens := app.TokenKeeper.GetTokens(ctx, nil)
+	require.NotEmpty(t, tokens)
+	addr := sdk.AccAddress("addr")
+
+	transfer := &ibctransfertypes.MsgTransfer{Token: sdk.NewInt64Coin("uiris", 100)}
+	lptTransfer := &ibctransfertypes.MsgTransfer{Token: sdk.NewInt64Coin(coinswaptypes.GetLptDenom(1), 100)}
+
+	// the proposals whose msgs are executed by the group MsgExec
+	proposals := groupKeeper{}
+	for id, msg := range []sdk.Msg{transfer, lptTransfer} {
+		proposal := &group.Proposal{Id: uint64(id + 1)}
+		require.NoError(t, proposal.SetMsgs([]sdk.Msg{msg}))
+		proposals[proposal.Id] = proposal
+	}
+	groupExec := func(id uint64) sdk.Msg {
+		return &group.MsgExec{ProposalId: id}
+	}
+	decorator := NewValidateTokenDecorator(app.TokenKeeper, proposals)
+	burn := &tokentypes.MsgBurnToken{Symbol: tokens[0].GetSymbol(), Amount: 100, Sender: addr.String()}
+	nonNativeBurn := &tokentypes.MsgBurnToken{Symbol: "nonnative", Amount: 100, Sender: addr.String()}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(addr, msgs)
+		return &msg
+	}
+	proposal := func(msgs ...sdk.Msg) sdk.Msg {
+		msg, err := group.NewMsgSubmitProposal(addr.String(), []string{addr.String()}, msgs, "", group.Exec_EXEC_TRY)
+		require.NoError(t, err)
+		return msg
+	}
+	nest := func(depth int, msg sdk.Msg) sdk.Msg {
+		for i := 0; i < depth; i++ {
+			msg = exec(msg)
+		}
+		return msg
+	}
+
+	tests := []struct {
+		name       string
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"transfer", []sdk.Msg{transfer}, true},
+		{"lpt transfer", []sdk.Msg{lptTransfer}, false},
+		{"burn", []sdk.Msg{burn}, true},
+		{"non-native burn", []sdk.Msg{nonNativeBurn}, false},
+		{"transfer in authz exec", []sdk.Msg{exec(transfer)}, true},
+		{"lpt transfer in authz exec", []sdk.Msg{exec(transfer, lptTransfer)}, false},
+		{"burn in authz exec", []sdk.Msg{exec(burn)}, true},
+		{"non-native burn in authz exec", []sdk.Msg{exec(burn, nonNativeBurn)}, false},
+		{"transfer in group proposal", []sdk.Msg{proposal(transfer)}, true},
+		{"lpt transfer in group proposal", []sdk.Msg{proposal(lptTransfer)}, false},
+		{"burn in group proposal", []sdk.Msg{proposal(burn)}, true},
+		{"non-native burn in group proposal", []sdk.Msg{proposal(nonNativeBurn)}, false},
+		{"transfer executed by group proposal", []sdk.Msg{groupExec(1)}, true},
+		{"lpt transfer executed by group proposal", []sdk.Msg{groupExec(2)}, false},
+		{"lpt transfer executed by group proposal in authz exec", []sdk.Msg{exec(groupExec(2))}, false},
+		{"unknown group proposal", []sdk.Msg{groupExec(3)}, true},
+		{"lpt transfer in group proposal in authz exec", []sdk.Msg{exec(proposal(lptTransfer))}, false},
+		{"non-native burn in authz exec in group proposal", []sdk.Msg{proposal(exec(nonNativeBurn))}, false},
+		{"transfer at the max depth", []sdk.Msg{nest(MaxNestedMsgDepth, transfer)}, true},
+		{"lpt transfer at the max depth", []sdk.Msg{nest(MaxNestedMsgDepth, lptTransfer)}, false},
+		{"transfer beyond the max depth", []sdk.Msg{nest(MaxNestedMsgDepth+1, transfer)}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(ctx, feeTx{msgs: tc.msgs}, false, next)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultMaxBypassMinFeeMsgGasUsage is the default max gas of a tx bypassing the min fee
+const DefaultMaxBypassMinFeeMsgGasUsage uint64 = 200_000
+
+// FeeConverter converts the fees paid in other tokens to the native token
+type FeeConverter interface {
+	ConvertFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins
+}
+
+// GlobalFeeKeeper provides the chain-level minimum gas prices
+type GlobalFeeKeeper interface {
+	GetMinimumGasPrices(ctx sdk.Context) sdk.DecCoins
+}
+
+// MinFeeDecorator checks the fee of the tx against the global minimum gas prices in both CheckTx and
+// DeliverTx, combined with the minimum gas prices of the validator in CheckTx by taking the max.
+// In CheckTx only, the tx is exempt if all its messages are of the bypass types and it does not
+// exceed the max gas, as the bypass is local config of the validator.
+// The fees paid in other tokens are converted to the native token by the optional fee converter.
+// It must be placed before the DeductFeeDecorator, whose default fee checker is relieved of the
+// minimum gas prices of the validator.
+type MinFeeDecorator struct {
+	bypassMinFeeMsgTypes       map[string]bool
+	maxBypassMinFeeMsgGasUsage uint64
+	feeConverter               FeeConverter
+	globalFeeKeeper            GlobalFeeKeeper
+}
+
+// NewMinFeeDecorator returns an instance of MinFeeDecorator
+func NewMinFeeDecorator(
+	bypassMinFeeMsgTypes []string,
+	maxBypassMinFeeMsgGasUsage uint64,
+	feeConverter FeeConverter,
+	globalFeeKeeper GlobalFeeKeeper,
+) MinFeeDecorator {
+	msgTypes := make(map[string]bool, len(bypassMinFeeMsgTypes))
+	for _, msgType := range bypassMinFeeMsgTypes {
+		msgTypes[msgType] = true
+	}
+	if maxBypassMinFeeMsgGasUsage == 0 {
+		maxBypassMinFeeMsgGasUsage = DefaultMaxBypassMinFeeMsgGasUsage
+	}
+	return MinFeeDecorator{
+		bypassMinFeeMsgTypes:       msgTypes,
+		maxBypassMinFeeMsgGasUsage: maxBypassMinFeeMsgGasUsage,
+		feeConverter:               feeConverter,
+		globalFeeKeeper:            globalFeeKeeper,
+	}
+}
+
+// AnteHandle checks the transaction
+func (mfd MinFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the gentxs are delivered at genesis without fees
+	if simulate || (!ctx.IsCheckTx() && ctx.BlockHeight() == 0) {
+		return next(ctx, tx, simulate)
+	}
+
+	minGasPrices := mfd.getGlobalMinGasPrices(ctx)
+	// the minimum gas prices of the validator are local config, only for local mempool purposes,
+	// which the bypass txs are exempted from, while the global minimum gas prices are enforced on
+	// all the txs in both CheckTx and DeliverTx, so that a tx admitted to the mempool is not
+	// rejected in the block before paying its fees
+	if ctx.IsCheckTx() && !mfd.bypassMinFee(feeTx) {
+		minGasPrices = combineMinGasPrices(minGasPrices, ctx.MinGasPrices())
+	}
+
+	if !minGasPrices.IsZero() {
+		fee := feeTx.GetFee()
+		if mfd.feeConverter != nil {
+			fee = mfd.feeConverter.ConvertFees(ctx, fee)
+		}
+		if err := checkMinGasPrices(fee, feeTx.GetGas(), minGasPrices); err != nil {
+			return ctx, err
+		}
+	}
+
+	if !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	return next(ctx.WithMinGasPrices(sdk.DecCoins{}), tx, simulate)
+}
+
+// getGlobalMinGasPrices returns the global minimum gas prices, if any
+func (mfd MinFeeDecorator) getGlobalMinGasPrices(ctx sdk.Context) sdk.DecCoins {
+	if mfd.globalFeeKeeper == nil {
+		return sdk.DecCoins{}
+	}
+	return mfd.globalFeeKeeper.GetMinimumGasPrices(ctx)
+}
+
+// bypassMinFee returns true if all the messages of the tx are of the bypass types and
+// the gas of the tx does not exceed the max gas of the bypass
+func (mfd MinFeeDecorator) bypassMinFee(tx sdk.FeeTx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 || tx.GetGas() > mfd.maxBypassMinFeeMsgGasUsage {
+		return false
+	}
+	for _, msg := range msgs {
+		if !mfd.bypassMinFeeMsgTypes[sdk.MsgTypeURL(msg)] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkMinGasPrices returns err if the fee does not meet any of the minimum gas prices,
+// where the required fee = ceil(minGasPrice * gasLimit)
+func checkMinGasPrices(fee sdk.Coins, gas uint64, minGasPrices sdk.DecCoins) error {
+	if minGasPrices.IsZero() {
+		return nil
+	}
+
+	glDec := sdk.NewDec(int64(gas))
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+	for i, gp := range minGasPrices {
+		requiredFees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+	}
+
+	if !fee.IsAnyGTE(requiredFees) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, requiredFees)
+	}
+	return nil
+}
+
+// combineMinGasPrices returns the global minimum gas prices raised to the minimum gas prices of
+// the validator in the same denoms, or the latter if there are no global minimum gas prices
+func combineMinGasPrices(globalMinGasPrices, localMinGasPrices sdk.DecCoins) sdk.DecCoins {
+	if globalMinGasPrices.IsZero() {
+		return localMinGasPrices
+	}
+
+	combined := make(sdk.DecCoins, len(globalMinGasPrices))
+	for i, gp := range globalMinGasPrices {
+		combined[i] = gp
+		if local := localMinGasPrices.AmountOf(gp.Denom); local.GT(gp.Amount) {
+			combined[i] = sdk.NewDecCoinFromDec(gp.Denom, local)
+		}
+	}
+	return combined
+}
@@ -0,0 +1,195 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+type feeTx struct {
+	sdk.Tx
+	msgs []sdk.Msg
+	fee  sdk.Coins
+	gas  uint64
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx feeTx) GetGas() uint64             { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx feeTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx feeTx) FeeGranter() sdk.AccAddress { return nil }
+
+func TestMinFeeDecorator(t *testing.T) {
+	bypassMsgTypes := []string{
+		sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{}),
+		sdk.MsgTypeURL(&ibcclienttypes.MsgUpdateClient{}),
+	}
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1)))
+	// the required fee of 100000 gas
+	requiredFee := sdk.NewCoins(sdk.NewInt64Coin("uiris", 20000))
+
+	recvPacket := &ibcchanneltypes.MsgRecvPacket{}
+	updateClient := &ibcclienttypes.MsgUpdateClient{}
+	send := &banktypes.MsgSend{}
+
+	tests := []struct {
+		name       string
+		msgs       []sdk.Msg
+		fee        sdk.Coins
+		gas        uint64
+		checkTx    bool
+		expectPass bool
+	}{
+		{"enough fee", []sdk.Msg{send}, requiredFee, 100000, true, true},
+		{"insufficient fee", []sdk.Msg{send}, nil, 100000, true, false},
+		{"bypass", []sdk.Msg{recvPacket}, nil, 100000, true, true},
+		{"bypass multiple types", []sdk.Msg{updateClient, recvPacket}, nil, 100000, true, true},
+		{"mixed with insufficient fee", []sdk.Msg{recvPacket, send}, nil, 100000, true, false},
+		{"mixed with enough fee", []sdk.Msg{recvPacket, send}, requiredFee, 100000, true, true},
+		{"bypass at the max gas", []sdk.Msg{recvPacket}, nil, DefaultMaxBypassMinFeeMsgGasUsage, true, true},
+		{"bypass above the max gas", []sdk.Msg{recvPacket}, nil, DefaultMaxBypassMinFeeMsgGasUsage + 1, true, false},
+		{"deliver tx", []sdk.Msg{send}, nil, 100000, false, true},
+	}
+
+	decorator := NewMinFeeDecorator(bypassMsgTypes, 0, nil, nil)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithIsCheckTx(tc.checkTx).WithMinGasPrices(minGasPrices)
+			tx := feeTx{msgs: tc.msgs, fee: tc.fee, gas: tc.gas}
+
+			var nextCtx *sdk.Context
+			_, err := decorator.AnteHandle(ctx, tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				nextCtx = &ctx
+				return ctx, nil
+			})
+			if !tc.expectPass {
+				require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+				require.Nil(t, nextCtx)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, nextCtx)
+			if tc.checkTx {
+				// the checked minimum gas prices are not enforced again by the following decorators
+				require.True(t, nextCtx.MinGasPrices().IsZero())
+			}
+		})
+	}
+}
+
+type feeConverter struct{}
+
+// ConvertFees converts the fees in uatom to twice the amount of uiris
+func (feeConverter) ConvertFees(_ sdk.Context, fees sdk.Coins) sdk.Coins {
+	converted := sdk.NewCoins()
+	for _, fee := range fees {
+		if fee.Denom == "uatom" {
+			fee = sdk.NewCoin("uiris", fee.Amount.MulRaw(2))
+		}
+		converted = converted.Add(fee)
+	}
+	return converted
+}
+
+func TestMinFeeDecoratorFeeConverter(t *testing.T) {
+	decorator := NewMinFeeDecorator(nil, 0, feeConverter{}, nil)
+	ctx := sdk.Context{}.WithIsCheckTx(true).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1))))
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	send := &banktypes.MsgSend{}
+
+	// the required fee of 100000 gas is 20000uiris, worth 10000uatom
+	_, err := decorator.AnteHandle(ctx, feeTx{msgs: []sdk.Msg{send}, fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10000)), gas: 100000}, false, next)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, feeTx{msgs: []sdk.Msg{send}, fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 9999)), gas: 100000}, false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	// the converted fee adds up with the fee paid in the native token
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uatom", 5000), sdk.NewInt64Coin("uiris", 10000))
+	_, err = decorator.AnteHandle(ctx, feeTx{msgs: []sdk.Msg{send}, fee: fee, gas: 100000}, false, next)
+	require.NoError(t, err)
+}
+
+func TestMinFeeDecoratorMaxGas(t *testing.T) {
+	decorator := NewMinFeeDecorator([]string{sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{})}, 500000, nil, nil)
+	ctx := sdk.Context{}.WithIsCheckTx(true).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1))))
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	_, err := decorator.AnteHandle(ctx, feeTx{msgs: []sdk.Msg{&ibcchanneltypes.MsgRecvPacket{}}, gas: 500000}, false, next)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, feeTx{msgs: []sdk.Msg{&ibcchanneltypes.MsgRecvPacket{}}, gas: 500001}, false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
+
+type globalFeeKeeper sdk.DecCoins
+
+func (k globalFeeKeeper) GetMinimumGasPrices(sdk.Context) sdk.DecCoins { return sdk.DecCoins(k) }
+
+func TestMinFeeDecoratorGlobalFee(t *testing.T) {
+	globalMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1)))
+	decorator := NewMinFeeDecorator([]string{sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{})}, 0, nil, globalFeeKeeper(globalMinGasPrices))
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	send := &banktypes.MsgSend{}
+	// the required fees of 100000 gas at the global and the local minimum gas prices
+	globalFee := sdk.NewCoins(sdk.NewInt64Coin("uiris", 20000))
+	localFee := sdk.NewCoins(sdk.NewInt64Coin("uiris", 30000))
+
+	tests := []struct {
+		name         string
+		msgs         []sdk.Msg
+		fee          sdk.Coins
+		checkTx      bool
+		height       int64
+		minGasPrices sdk.DecCoins
+		expectPass   bool
+	}{
+		{"deliver tx with the global fee", []sdk.Msg{send}, globalFee, false, 10, nil, true},
+		{"deliver tx with insufficient fee", []sdk.Msg{send}, nil, false, 10, nil, false},
+		{"deliver tx ignores the local minimum", []sdk.Msg{send}, globalFee, false, 10, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(3, 1))), true},
+		{"gentx", []sdk.Msg{send}, nil, false, 0, nil, true},
+		{"deliver tx ignores the bypass", []sdk.Msg{&ibcchanneltypes.MsgRecvPacket{}}, nil, false, 10, nil, false},
+		{"check tx with the bypass keeps the global fee", []sdk.Msg{&ibcchanneltypes.MsgRecvPacket{}}, nil, true, 10, nil, false},
+		{"check tx with the bypass ignores the local minimum", []sdk.Msg{&ibcchanneltypes.MsgRecvPacket{}}, globalFee, true, 10, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(3, 1))), true},
+		{"check tx with the global fee", []sdk.Msg{send}, globalFee, true, 10, nil, true},
+		{"check tx with insufficient fee", []sdk.Msg{send}, nil, true, 10, nil, false},
+		{"check tx below the higher local minimum", []sdk.Msg{send}, globalFee, true, 10, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(3, 1))), false},
+		{"check tx with the higher local minimum", []sdk.Msg{send}, localFee, true, 10, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(3, 1))), true},
+		{"check tx with a lower local minimum", []sdk.Msg{send}, globalFee, true, 10, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(1, 1))), true},
+		{"check tx with a local minimum in another denom", []sdk.Msg{send}, sdk.NewCoins(sdk.NewInt64Coin("uatom", 30000)), true, 10, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(3, 1))), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithIsCheckTx(tc.checkTx).WithBlockHeight(tc.height).WithMinGasPrices(tc.minGasPrices)
+			_, err := decorator.AnteHandle(ctx, feeTx{msgs: tc.msgs, fee: tc.fee, gas: 100000}, false, next)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+			}
+		})
+	}
+}
+
+func TestMinFeeDecoratorBypassCheckAndDeliver(t *testing.T) {
+	globalMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1)))
+	decorator := NewMinFeeDecorator([]string{sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{})}, 0, nil, globalFeeKeeper(globalMinGasPrices))
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	localMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(3, 1)))
+
+	// a bypass tx is either accepted or rejected in both CheckTx and DeliverTx
+	for _, fee := range []sdk.Coins{nil, sdk.NewCoins(sdk.NewInt64Coin("uiris", 20000))} {
+		tx := feeTx{msgs: []sdk.Msg{&ibcchanneltypes.MsgRecvPacket{}}, fee: fee, gas: 100000}
+		checkCtx := sdk.Context{}.WithIsCheckTx(true).WithBlockHeight(10).WithMinGasPrices(localMinGasPrices)
+		_, checkErr := decorator.AnteHandle(checkCtx, tx, false, next)
+		_, deliverErr := decorator.AnteHandle(sdk.Context{}.WithBlockHeight(10), tx, false, next)
+		require.Equal(t, checkErr == nil, deliverErr == nil, fee.String())
+		require.Equal(t, !fee.IsZero(), checkErr == nil, fee.String())
+	}
+}
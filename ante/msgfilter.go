@@ -0,0 +1,159 @@
+package app
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+)
+
+// MaxNestedMsgDepth is the max depth of the messages nested in authz MsgExec and group proposals
+const MaxNestedMsgDepth = 5
+
+var errNestedTooDeep = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages can't be nested deeper than %d", MaxNestedMsgDepth)
+
+// MsgFilterKeeper provides the type URLs of the disabled messages
+type MsgFilterKeeper interface {
+	GetDisabledMsgTypes(ctx sdk.Context) []string
+}
+
+// GroupKeeper provides the proposals executed by the group MsgExec
+type GroupKeeper interface {
+	Proposal(goCtx context.Context, request *group.QueryProposalRequest) (*group.QueryProposalResponse, error)
+}
+
+// MsgFilterDecorator refuses the tx containing any disabled message, including the messages nested
+// in authz MsgExec and group proposals on both submission and execution
+type MsgFilterDecorator struct {
+	mk MsgFilterKeeper
+	gk GroupKeeper
+}
+
+// NewMsgFilterDecorator returns an instance of MsgFilterDecorator
+func NewMsgFilterDecorator(mk MsgFilterKeeper, gk GroupKeeper) MsgFilterDecorator {
+	return MsgFilterDecorator{
+		mk: mk,
+		gk: gk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (mfd MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := mfd.checkDisabled(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// MsgFilterRouter wraps the msg router of the interchain account host to refuse the disabled messages
+// on execution, so that the packet carrying them is acknowledged with an error. Refusing the relayer
+// tx instead would leave the packet, and all the packets after it on an ordered channel, undelivered.
+type MsgFilterRouter struct {
+	icatypes.MessageRouter
+	mfd MsgFilterDecorator
+}
+
+// NewMsgFilterRouter returns an instance of MsgFilterRouter
+func NewMsgFilterRouter(router icatypes.MessageRouter, mk MsgFilterKeeper, gk GroupKeeper) MsgFilterRouter {
+	return MsgFilterRouter{
+		MessageRouter: router,
+		mfd:           NewMsgFilterDecorator(mk, gk),
+	}
+}
+
+// Handler returns the handler of the msg which fails if the msg or any of its nested msgs is disabled
+func (r MsgFilterRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.MessageRouter.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if err := r.mfd.checkDisabled(ctx, []sdk.Msg{msg}); err != nil {
+			return nil, err
+		}
+		return handler(ctx, msg)
+	}
+}
+
+// checkDisabled returns err if any of the msgs or their nested msgs is disabled
+func (mfd MsgFilterDecorator) checkDisabled(ctx sdk.Context, msgs []sdk.Msg) error {
+	msgTypes := mfd.mk.GetDisabledMsgTypes(ctx)
+	if len(msgTypes) == 0 {
+		return nil
+	}
+
+	disabled := make(map[string]bool, len(msgTypes))
+	for _, msgType := range msgTypes {
+		disabled[msgType] = true
+	}
+	return mfd.checkMsgs(ctx, msgs, disabled, 0)
+}
+
+// checkMsgs returns err if any of the msgs or their nested msgs is disabled, where depth is the depth of the msgs
+func (mfd MsgFilterDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg, disabled map[string]bool, depth int) error {
+	for _, msg := range msgs {
+		if msgType := sdk.MsgTypeURL(msg); disabled[msgType] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message %s is disabled", msgType)
+		}
+
+		nested, err := getNestedMsgs(msg, depth)
+		if err != nil {
+			return err
+		}
+		if msg, ok := msg.(*group.MsgExec); ok {
+			// the proposal may have been submitted before its msgs were disabled
+			if nested, err = getProposalMsgs(ctx, mfd.gk, msg.ProposalId, depth); err != nil {
+				return err
+			}
+		}
+		if err := mfd.checkMsgs(ctx, nested, disabled, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getProposalMsgs returns the msgs of the group proposal to be executed, if any.
+// The proposal failing to be found is left to the group MsgExec, which fails as well.
+func getProposalMsgs(ctx sdk.Context, gk GroupKeeper, proposalID uint64, depth int) ([]sdk.Msg, error) {
+	res, err := gk.Proposal(sdk.WrapSDKContext(ctx), &group.QueryProposalRequest{ProposalId: proposalID})
+	if err != nil || res.Proposal == nil {
+		return nil, nil
+	}
+
+	msgs, err := res.Proposal.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) > 0 && depth >= MaxNestedMsgDepth {
+		return nil, errNestedTooDeep
+	}
+	return msgs, nil
+}
+
+// getNestedMsgs returns the msgs nested in the authz MsgExec or the submitted group proposal.
+// It returns err if the msg at the given depth nests msgs beyond MaxNestedMsgDepth.
+func getNestedMsgs(msg sdk.Msg, depth int) ([]sdk.Msg, error) {
+	var (
+		msgs []sdk.Msg
+		err  error
+	)
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		msgs, err = msg.GetMessages()
+	case *group.MsgSubmitProposal:
+		msgs, err = msg.GetMsgs()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msgs) > 0 && depth >= MaxNestedMsgDepth {
+		return nil, errNestedTooDeep
+	}
+	return msgs, nil
+}
@@ -0,0 +1,124 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+)
+
+type msgFilterKeeper []string
+
+func (k msgFilterKeeper) GetDisabledMsgTypes(sdk.Context) []string { return k }
+
+type groupKeeper map[uint64]*group.Proposal
+
+func (k groupKeeper) Proposal(_ context.Context, req *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
+	proposal, ok := k[req.ProposalId]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound
+	}
+	return &group.QueryProposalResponse{Proposal: proposal}, nil
+}
+
+func TestMsgFilterDecorator(t *testing.T) {
+	send := &banktypes.MsgSend{}
+	transfer := &ibctransfertypes.MsgTransfer{}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(sdk.AccAddress("grantee"), msgs)
+		return &msg
+	}
+	proposal := func(msgs ...sdk.Msg) sdk.Msg {
+		msg, err := group.NewMsgSubmitProposal(sdk.AccAddress("admin").String(), nil, msgs, "", group.Exec_EXEC_UNSPECIFIED)
+		require.NoError(t, err)
+		return msg
+	}
+	nest := func(depth int, msg sdk.Msg) sdk.Msg {
+		for i := 0; i < depth; i++ {
+			msg = exec(msg)
+		}
+		return msg
+	}
+	// the proposals submitted before the msgs were disabled
+	proposals := groupKeeper{}
+	for id, msg := range []sdk.Msg{transfer, send} {
+		proposal := &group.Proposal{Id: uint64(id + 1)}
+		require.NoError(t, proposal.SetMsgs([]sdk.Msg{msg}))
+		proposals[proposal.Id] = proposal
+	}
+	groupExec := func(id uint64) sdk.Msg {
+		return &group.MsgExec{ProposalId: id}
+	}
+
+	tests := []struct {
+		name       string
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"enabled", []sdk.Msg{transfer}, true},
+		{"disabled", []sdk.Msg{transfer, send}, false},
+		{"enabled in authz exec", []sdk.Msg{exec(transfer)}, true},
+		{"disabled in authz exec", []sdk.Msg{exec(transfer, send)}, false},
+		{"disabled in nested authz exec", []sdk.Msg{exec(exec(send))}, false},
+		{"enabled in group proposal", []sdk.Msg{proposal(transfer)}, true},
+		{"disabled in group proposal", []sdk.Msg{proposal(send)}, false},
+		{"disabled in authz exec in group proposal", []sdk.Msg{proposal(exec(send))}, false},
+		{"enabled in group exec", []sdk.Msg{groupExec(1)}, true},
+		{"disabled in group exec", []sdk.Msg{groupExec(2)}, false},
+		{"disabled in group exec in authz exec", []sdk.Msg{exec(groupExec(2))}, false},
+		{"group exec of unknown proposal", []sdk.Msg{groupExec(3)}, true},
+		{"enabled at the max depth", []sdk.Msg{nest(MaxNestedMsgDepth, transfer)}, true},
+		{"enabled beyond the max depth", []sdk.Msg{nest(MaxNestedMsgDepth+1, transfer)}, false},
+	}
+
+	decorator := NewMsgFilterDecorator(msgFilterKeeper{sdk.MsgTypeURL(send)}, proposals)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(sdk.Context{}, feeTx{msgs: tc.msgs}, false, next)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+			}
+		})
+	}
+
+	// no message is disabled by default
+	decorator = NewMsgFilterDecorator(msgFilterKeeper{}, proposals)
+	_, err := decorator.AnteHandle(sdk.Context{}, feeTx{msgs: []sdk.Msg{send}}, false, next)
+	require.NoError(t, err)
+}
+
+type msgRouter map[string]baseapp.MsgServiceHandler
+
+func (r msgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler { return r[sdk.MsgTypeURL(msg)] }
+
+func TestMsgFilterRouter(t *testing.T) {
+	send := &banktypes.MsgSend{}
+	transfer := &ibctransfertypes.MsgTransfer{}
+	exec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{send})
+	handle := func(sdk.Context, sdk.Msg) (*sdk.Result, error) { return &sdk.Result{}, nil }
+	router := NewMsgFilterRouter(
+		msgRouter{sdk.MsgTypeURL(send): handle, sdk.MsgTypeURL(transfer): handle, sdk.MsgTypeURL(&exec): handle},
+		msgFilterKeeper{sdk.MsgTypeURL(send)},
+		groupKeeper{},
+	)
+
+	// the interchain account host acknowledges the packet with the error returned by the handler
+	_, err := router.Handler(transfer)(sdk.Context{}, transfer)
+	require.NoError(t, err)
+	_, err = router.Handler(send)(sdk.Context{}, send)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = router.Handler(&exec)(sdk.Context{}, &exec)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Nil(t, router.Handler(&group.MsgExec{}))
+}
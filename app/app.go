@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/spf13/cast"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 	tmjson "github.com/tendermint/tendermint/libs/json"
@@ -131,12 +133,21 @@ import (
 	irishubante "github.com/irisnet/irishub/ante"
 	irisappparams "github.com/irisnet/irishub/app/params"
 	"github.com/irisnet/irishub/lite"
+	"github.com/irisnet/irishub/modules/globalfee"
+	globalfeekeeper "github.com/irisnet/irishub/modules/globalfee/keeper"
+	globalfeetypes "github.com/irisnet/irishub/modules/globalfee/types"
 	"github.com/irisnet/irishub/modules/guardian"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
 	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 	"github.com/irisnet/irishub/modules/mint"
 	mintkeeper "github.com/irisnet/irishub/modules/mint/keeper"
 	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/modules/msgfilter"
+	msgfilterkeeper "github.com/irisnet/irishub/modules/msgfilter/keeper"
+	msgfiltertypes "github.com/irisnet/irishub/modules/msgfilter/types"
+	"github.com/irisnet/irishub/modules/txfees"
+	txfeeskeeper "github.com/irisnet/irishub/modules/txfees/keeper"
+	txfeestypes "github.com/irisnet/irishub/modules/txfees/types"
 
 	"github.com/irisnet/irismod/modules/farm"
 	farmkeeper "github.com/irisnet/irismod/modules/farm/keeper"
@@ -206,6 +217,9 @@ var (
 		ica.AppModuleBasic{},
 
 		guardian.AppModuleBasic{},
+		txfees.AppModuleBasic{},
+		globalfee.AppModuleBasic{},
+		msgfilter.AppModuleBasic{},
 		token.AppModuleBasic{},
 		record.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
@@ -225,7 +239,7 @@ var (
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:     nil,
 		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
+		minttypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
@@ -301,6 +315,9 @@ type IrisApp struct {
 	scopedTIBCMockKeeper capabilitykeeper.ScopedKeeper
 
 	GuardianKeeper        guardiankeeper.Keeper
+	TxFeesKeeper          txfeeskeeper.Keeper
+	GlobalFeeKeeper       globalfeekeeper.Keeper
+	MsgFilterKeeper       msgfilterkeeper.Keeper
 	TokenKeeper           tokenkeeper.Keeper
 	RecordKeeper          recordkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
@@ -397,7 +414,7 @@ func NewIrisApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
-		guardiantypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
+		guardiantypes.StoreKey, txfeestypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
 		coinswaptypes.StoreKey, servicetypes.StoreKey, oracletypes.StoreKey, randomtypes.StoreKey,
 		farmtypes.StoreKey, feegrant.StoreKey, tibchost.StoreKey, tibcnfttypes.StoreKey, tibcmttypes.StoreKey, mttypes.StoreKey,
 		authzkeeper.StoreKey, group.StoreKey, icahosttypes.StoreKey,
@@ -461,12 +478,12 @@ func NewIrisApp(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
 
-	app.MintKeeper = mintkeeper.NewKeeper(
+	app.TokenKeeper = tokenkeeper.NewKeeper(
 		appCodec,
-		keys[minttypes.StoreKey],
-		app.GetSubspace(minttypes.ModuleName),
-		app.AccountKeeper,
+		keys[tokentypes.StoreKey],
+		app.GetSubspace(tokentypes.ModuleName),
 		app.BankKeeper,
+		app.ModuleAccountAddrs(),
 		authtypes.FeeCollectorName,
 	)
 
@@ -480,6 +497,19 @@ func NewIrisApp(
 		authtypes.FeeCollectorName,
 	)
 
+	app.MintKeeper = mintkeeper.NewKeeper(
+		appCodec,
+		keys[minttypes.StoreKey],
+		app.GetSubspace(minttypes.ModuleName),
+		&stakingKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.TokenKeeper,
+		app.DistrKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec,
 		keys[slashingtypes.StoreKey],
@@ -542,6 +572,8 @@ func NewIrisApp(
 		scopedIBCKeeper,
 	)
 
+	app.MsgFilterKeeper = msgfilterkeeper.NewKeeper(app.GetSubspace(msgfiltertypes.ModuleName))
+
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec,
 		keys[icahosttypes.StoreKey],
@@ -551,7 +583,8 @@ func NewIrisApp(
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
 		scopedICAHostKeeper,
-		app.MsgServiceRouter(),
+		// the disabled msgs of the interchain account txs are refused by the host with an error acknowledgement
+		irishubante.NewMsgFilterRouter(app.MsgServiceRouter(), app.MsgFilterKeeper, app.GroupKeeper),
 	)
 	icaModule := ica.NewAppModule(nil, &app.ICAHostKeeper)
 	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
@@ -635,15 +668,8 @@ func NewIrisApp(
 	app.GuardianKeeper = guardiankeeper.NewKeeper(
 		appCodec,
 		keys[guardiantypes.StoreKey],
-	)
-
-	app.TokenKeeper = tokenkeeper.NewKeeper(
-		appCodec,
-		keys[tokentypes.StoreKey],
-		app.GetSubspace(tokentypes.ModuleName),
-		app.BankKeeper,
-		app.ModuleAccountAddrs(),
-		authtypes.FeeCollectorName,
+		app.GetSubspace(guardiantypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.RecordKeeper = recordkeeper.NewKeeper(
@@ -669,6 +695,18 @@ func NewIrisApp(
 		authtypes.FeeCollectorName,
 	)
 
+	app.TxFeesKeeper = txfeeskeeper.NewKeeper(
+		appCodec,
+		keys[txfeestypes.StoreKey],
+		app.GetSubspace(txfeestypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.CoinswapKeeper,
+		authtypes.FeeCollectorName,
+	)
+
+	app.GlobalFeeKeeper = globalfeekeeper.NewKeeper(app.GetSubspace(globalfeetypes.ModuleName))
+
 	app.ServiceKeeper = servicekeeper.NewKeeper(
 		appCodec,
 		keys[servicetypes.StoreKey],
@@ -707,7 +745,7 @@ func NewIrisApp(
 
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, mint.NewParamChangeProposalHandler(app.MintKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
@@ -767,7 +805,7 @@ func NewIrisApp(
 		icaModule,
 		nfttransferModule,
 		mttransferModule,
-		guardian.NewAppModule(appCodec, app.GuardianKeeper),
+		guardian.NewAppModule(appCodec, app.GuardianKeeper, app.AccountKeeper, app.BankKeeper),
 		token.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
 		record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper),
@@ -778,6 +816,9 @@ func NewIrisApp(
 		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
 		random.NewAppModule(appCodec, app.RandomKeeper, app.AccountKeeper, app.BankKeeper),
 		farm.NewAppModule(appCodec, app.FarmKeeper, app.AccountKeeper, app.BankKeeper),
+		txfees.NewAppModule(appCodec, app.TxFeesKeeper),
+		globalfee.NewAppModule(appCodec, app.GlobalFeeKeeper),
+		msgfilter.NewAppModule(appCodec, app.MsgFilterKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -823,6 +864,9 @@ func NewIrisApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txfeestypes.ModuleName,
+		globalfeetypes.ModuleName,
+		msgfiltertypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		//sdk module
@@ -863,6 +907,9 @@ func NewIrisApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txfeestypes.ModuleName,
+		globalfeetypes.ModuleName,
+		msgfiltertypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -880,8 +927,6 @@ func NewIrisApp(
 		stakingtypes.ModuleName,
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
-		minttypes.ModuleName,
-		crisistypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
@@ -895,6 +940,8 @@ func NewIrisApp(
 
 		//self module
 		tokentypes.ModuleName,
+		// mint validates the tokens of its denom inflations
+		minttypes.ModuleName,
 		tibchost.ModuleName,
 		ibctransfertypes.ModuleName,
 		nfttypes.ModuleName,
@@ -909,6 +956,12 @@ func NewIrisApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txfeestypes.ModuleName,
+		globalfeetypes.ModuleName,
+		msgfiltertypes.ModuleName,
+
+		// crisis needs to be last so that the invariants are asserted on the whole genesis state
+		crisistypes.ModuleName,
 	)
 
 	cfg := module.NewConfigurator(appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -938,7 +991,7 @@ func NewIrisApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
 
-		guardian.NewAppModule(appCodec, app.GuardianKeeper),
+		guardian.NewAppModule(appCodec, app.GuardianKeeper, app.AccountKeeper, app.BankKeeper),
 		token.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
 		record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper),
@@ -967,11 +1020,16 @@ func NewIrisApp(
 				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			BankKeeper:           app.BankKeeper,
-			TokenKeeper:          app.TokenKeeper,
-			OracleKeeper:         app.OracleKeeper,
-			GuardianKeeper:       app.GuardianKeeper,
-			BypassMinFeeMsgTypes: []string{},
+			BankKeeper:                 app.BankKeeper,
+			TokenKeeper:                app.TokenKeeper,
+			OracleKeeper:               app.OracleKeeper,
+			GuardianKeeper:             app.GuardianKeeper,
+			TxFeesKeeper:               app.TxFeesKeeper,
+			GlobalFeeKeeper:            app.GlobalFeeKeeper,
+			MsgFilterKeeper:            app.MsgFilterKeeper,
+			GroupKeeper:                app.GroupKeeper,
+			BypassMinFeeMsgTypes:       cast.ToStringSlice(appOpts.Get(irisappparams.BypassMinFeeMsgTypesKey)),
+			MaxBypassMinFeeMsgGasUsage: cast.ToUint64(appOpts.Get(irisappparams.MaxBypassMinFeeMsgGasUsageKey)),
 		},
 	)
 	if err != nil {
@@ -1188,6 +1246,10 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govv1.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
+	paramsKeeper.Subspace(guardiantypes.ModuleName)
+	paramsKeeper.Subspace(txfeestypes.ModuleName)
+	paramsKeeper.Subspace(globalfeetypes.ModuleName)
+	paramsKeeper.Subspace(msgfiltertypes.ModuleName)
 	paramsKeeper.Subspace(tokentypes.ModuleName)
 	paramsKeeper.Subspace(recordtypes.ModuleName)
 	paramsKeeper.Subspace(htlctypes.ModuleName)
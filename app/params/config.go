@@ -10,6 +10,11 @@ var (
 	// nolint: gosec
 	BypassMinFeeMsgTypesKey = "bypass-min-fee-msg-types"
 
+	// MaxBypassMinFeeMsgGasUsageKey defines the configuration key for the
+	// MaxBypassMinFeeMsgGasUsage value.
+	// nolint: gosec
+	MaxBypassMinFeeMsgGasUsageKey = "max-bypass-min-fee-msg-gas-usage"
+
 	// CustomConfigTemplate defines Gaia's custom application configuration TOML
 	// template. It extends the core SDK template.
 	CustomConfigTemplate = serverconfig.DefaultConfigTemplate + `
@@ -17,11 +22,16 @@ var (
 ###                        Custom Gaia Configuration                        ###
 ###############################################################################
 # bypass-min-fee-msg-types defines custom message types the operator may set that
-# will bypass minimum fee checks during CheckTx.
+# will bypass the minimum-gas-prices of the validator during CheckTx, while the
+# global minimum gas prices of the globalfee module still apply.
 #
 # Example:
 # ["/ibc.core.channel.v1.MsgRecvPacket", "/ibc.core.channel.v1.MsgAcknowledgement", ...]
 bypass-min-fee-msg-types = [{{ range .BypassMinFeeMsgTypes }}{{ printf "%q, " . }}{{end}}]
+
+# max-bypass-min-fee-msg-gas-usage defines the max gas of a transaction bypassing
+# the minimum fee checks, 200000 if zero.
+max-bypass-min-fee-msg-gas-usage = {{ .MaxBypassMinFeeMsgGasUsage }}
 `
 )
 
@@ -32,4 +42,8 @@ type CustomAppConfig struct {
 	// BypassMinFeeMsgTypes defines custom message types the operator may set that
 	// will bypass minimum fee checks during CheckTx.
 	BypassMinFeeMsgTypes []string `mapstructure:"bypass-min-fee-msg-types"`
+
+	// MaxBypassMinFeeMsgGasUsage defines the max gas of a transaction bypassing
+	// the minimum fee checks.
+	MaxBypassMinFeeMsgGasUsage uint64 `mapstructure:"max-bypass-min-fee-msg-gas-usage"`
 }
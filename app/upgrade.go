@@ -64,6 +64,7 @@ import (
 	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 	"github.com/irisnet/irishub/modules/mint"
 	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	txfeestypes "github.com/irisnet/irishub/modules/txfees/types"
 )
 
 // RegisterUpgradePlan register a handler of upgrade plan
@@ -154,7 +155,7 @@ func (app *IrisApp) RegisterUpgradePlan(cfg module.Configurator) {
 	//TODO
 	app.RegisterUpgradeHandler("v1.4",
 		&store.StoreUpgrades{
-			Added: []string{authzkeeper.StoreKey, group.StoreKey},
+			Added: []string{authzkeeper.StoreKey, group.StoreKey, txfeestypes.StoreKey},
 		},
 		func(ctx sdk.Context, plan sdkupgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 			// version upgrade:
@@ -170,6 +171,9 @@ func (app *IrisApp) RegisterUpgradePlan(cfg module.Configurator) {
 			// added module:
 			//  authz
 			//  group
+			//  txfees
+			//  globalfee
+			//  msgfilter
 
 			// ibc application:
 			//  27-interchain-accounts
@@ -227,6 +231,23 @@ func (app *IrisApp) RegisterUpgradePlan(cfg module.Configurator) {
 			ctx.Logger().Info("start to init interchainaccount module...")
 			// initialize ICS27 module
 			icaModule.InitModule(ctx, controllerParams, hostParams)
+
+			// oracle feeds are authorized by the oracle-operator role instead of the super status,
+			// which is granted to the existing supers by the governance authority
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+			var supers []guardiantypes.Super
+			app.GuardianKeeper.IterateSupers(ctx, func(super guardiantypes.Super) bool {
+				supers = append(supers, super)
+				return false
+			})
+			for _, super := range supers {
+				address, err := sdk.AccAddressFromBech32(super.Address)
+				if err != nil {
+					return nil, err
+				}
+				app.GuardianKeeper.GrantRole(ctx, guardiantypes.NewRoleGrant(address, guardiantypes.RoleOracleOperator, authority))
+			}
+
 			ctx.Logger().Info("start to run module migrations...")
 			return app.mm.RunMigrations(ctx, cfg, fromVM)
 		},
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+)
+
+const flagDescription = "description"
+
+// AddGenesisSuperCmd returns add-genesis-super cobra Command.
+func AddGenesisSuperCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-super [address]",
+		Short: "Add a genesis super to genesis.json",
+		Long: `Add a genesis super of the guardian module to genesis.json. The guardian
+genesis state requires at least one genesis super.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			description, _ := cmd.Flags().GetString(flagDescription)
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var guardianGenState guardiantypes.GenesisState
+			if err := cdc.UnmarshalJSON(appState[guardiantypes.ModuleName], &guardianGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal guardian genesis state: %w", err)
+			}
+
+			for _, super := range guardianGenState.Supers {
+				if super.Address == addr.String() {
+					return fmt.Errorf("cannot add super at existing address %s", addr)
+				}
+			}
+			guardianGenState.Supers = append(
+				guardianGenState.Supers,
+				guardiantypes.NewSuper(description, guardiantypes.Genesis, addr, addr),
+			)
+
+			guardianGenStateBz, err := cdc.MarshalJSON(&guardianGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal guardian genesis state: %w", err)
+			}
+
+			appState[guardiantypes.ModuleName] = guardianGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagDescription, "genesis", "description of the genesis super")
+
+	return cmd
+}
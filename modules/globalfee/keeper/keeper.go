@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/globalfee/types"
+)
+
+// Keeper of the globalfee params
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper returns a globalfee keeper
+func NewKeeper(paramSpace paramtypes.Subspace) Keeper {
+	return Keeper{
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// GetParamSet returns globalfee params from the global param store
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParamSet sets globalfee params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetMinimumGasPrices returns the minimum gas prices of the chain
+func (k Keeper) GetMinimumGasPrices(ctx sdk.Context) sdk.DecCoins {
+	var minGasPrices sdk.DecCoins
+	k.paramSpace.Get(ctx, types.KeyMinimumGasPrices, &minGasPrices)
+	return minGasPrices
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	tests := []struct {
+		name             string
+		minimumGasPrices sdk.DecCoins
+		expectPass       bool
+	}{
+		{"default", DefaultParams().MinimumGasPrices, true},
+		{"minimum gas prices", sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1))), true},
+		{"multiple denoms", sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 1)),
+			sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1)),
+		), true},
+		{"negative price", sdk.DecCoins{{Denom: "uiris", Amount: sdk.NewDecWithPrec(-2, 1)}}, false},
+		{"invalid denom", sdk.DecCoins{{Denom: "a", Amount: sdk.NewDecWithPrec(2, 1)}}, false},
+		{"unsorted denoms", sdk.DecCoins{
+			{Denom: "uiris", Amount: sdk.NewDecWithPrec(2, 1)},
+			{Denom: "uatom", Amount: sdk.NewDecWithPrec(1, 1)},
+		}, false},
+	}
+	for _, tc := range tests {
+		err := ValidateGenesis(*NewGenesisState(NewParams(tc.minimumGasPrices)))
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyMinimumGasPrices = []byte("MinimumGasPrices")
+)
+
+// ParamKeyTable for globalfee module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs a Params
+func NewParams(minimumGasPrices sdk.DecCoins) Params {
+	return Params{
+		MinimumGasPrices: minimumGasPrices,
+	}
+}
+
+// DefaultParams returns default globalfee module parameters, under which only the minimum gas
+// prices of the validators are enforced
+func DefaultParams() Params {
+	return Params{
+		MinimumGasPrices: sdk.DecCoins{},
+	}
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinimumGasPrices, &p.MinimumGasPrices, validateMinimumGasPrices),
+	}
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
+
+func validateMinimumGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid minimum gas prices %s: %s", v, err)
+	}
+	return nil
+}
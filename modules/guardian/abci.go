@@ -0,0 +1,36 @@
+package guardian
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// EndBlocker deletes the supers and the pending actions which have expired
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	for _, super := range k.PruneExpiredSupers(ctx) {
+		k.Logger(ctx).Info("super expired", "address", super.Address)
+		k.RecordHistory(ctx, types.HistoryActionExpireSuper, "", super.Address, types.AttributeValueExpired)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeleteSuper,
+				sdk.NewAttribute(types.AttributeKeySuperAddress, super.Address),
+				sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueExpired),
+			),
+		)
+	}
+
+	for _, action := range k.PruneExpiredActions(ctx) {
+		k.Logger(ctx).Info("pending action expired", "id", action.Id)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireAction,
+				sdk.NewAttribute(types.AttributeKeyActionID, sdk.NewUint(action.Id).String()),
+				sdk.NewAttribute(types.AttributeKeyActionType, action.ActionType.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,58 @@
+package guardian_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+
+	"github.com/irisnet/irishub/modules/guardian"
+	"github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: now})
+
+	_, _, genesis := testdata.KeyTestPubAddr()
+	_, _, byHeight := testdata.KeyTestPubAddr()
+	_, _, byTime := testdata.KeyTestPubAddr()
+	_, _, permanent := testdata.KeyTestPubAddr()
+
+	heightSuper := types.NewSuper("height", types.Ordinary, byHeight, genesis)
+	heightSuper.ExpirationHeight = 11
+	expiration := now.Add(time.Hour)
+	timeSuper := types.NewSuper("time", types.Ordinary, byTime, genesis)
+	timeSuper.ExpirationTime = &expiration
+
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, genesis, genesis))
+	app.GuardianKeeper.AddSuper(ctx, heightSuper)
+	app.GuardianKeeper.AddSuper(ctx, timeSuper)
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("permanent", types.Ordinary, permanent, genesis))
+
+	guardian.EndBlocker(ctx, app.GuardianKeeper)
+	require.True(t, app.GuardianKeeper.Authorized(ctx, byHeight))
+	require.True(t, app.GuardianKeeper.Authorized(ctx, byTime))
+
+	ctx = ctx.WithBlockHeight(11)
+	guardian.EndBlocker(ctx, app.GuardianKeeper)
+	require.False(t, app.GuardianKeeper.Authorized(ctx, byHeight))
+	require.True(t, app.GuardianKeeper.Authorized(ctx, byTime))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeDeleteSuper, events[0].Type)
+	require.Equal(t, types.AttributeValueExpired, string(events[0].Attributes[1].Value))
+
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(expiration)
+	guardian.EndBlocker(ctx, app.GuardianKeeper)
+	require.False(t, app.GuardianKeeper.Authorized(ctx, byTime))
+	require.True(t, app.GuardianKeeper.Authorized(ctx, genesis))
+	require.True(t, app.GuardianKeeper.Authorized(ctx, permanent))
+}
@@ -38,6 +38,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	cfg := simapp.NewConfig()
 	cfg.NumValidators = 1
 
+	// the test network has a genesis super besides the one added here
 	privKey, pubKey, addr = testdata.KeyTestPubAddr()
 	guardian := guardiantypes.NewSuper("test", guardiantypes.Genesis, addr, addr)
 
@@ -90,7 +91,7 @@ func (s *IntegrationTestSuite) TestGuardian() {
 	s.Require().NoError(err)
 	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType))
 	supersResp := respType.(*guardiantypes.QuerySupersResponse)
-	s.Require().Equal(1, len(supersResp.Supers))
+	s.Require().Equal(2, len(supersResp.Supers))
 
 	//------test GetCmdCreateSuper()-------------
 	args = []string{
@@ -116,7 +117,7 @@ func (s *IntegrationTestSuite) TestGuardian() {
 	s.Require().NoError(err)
 	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType))
 	supersResp = respType.(*guardiantypes.QuerySupersResponse)
-	s.Require().Equal(2, len(supersResp.Supers))
+	s.Require().Equal(3, len(supersResp.Supers))
 
 	//------test GetCmdDeleteSuper()-------------
 	args = []string{
@@ -140,5 +141,5 @@ func (s *IntegrationTestSuite) TestGuardian() {
 	s.Require().NoError(err)
 	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType))
 	supersResp = respType.(*guardiantypes.QuerySupersResponse)
-	s.Require().Equal(1, len(supersResp.Supers))
+	s.Require().Equal(2, len(supersResp.Supers))
 }
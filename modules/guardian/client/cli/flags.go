@@ -8,16 +8,36 @@ import (
 const (
 	FlagAddress     = "address"
 	FlagDescription = "description"
+	FlagRole        = "role"
+	FlagAccountType = "account-type"
+	FlagAddedBy     = "added-by"
+	FlagTarget      = "target"
+
+	FlagExpirationHeight = "expiration-height"
+	FlagExpirationTime   = "expiration-time"
 )
 
 // common flagsets to add to various functions
 var (
 	FsAddGuardian    = flag.NewFlagSet("", flag.ContinueOnError)
 	FsDeleteGuardian = flag.NewFlagSet("", flag.ContinueOnError)
+	FsUpdateGuardian = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRole           = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQuerySupers    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryHistory   = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsAddGuardian.String(FlagAddress, "", "bech32 encoded account address")
 	FsAddGuardian.String(FlagDescription, "", "description of account")
+	FsAddGuardian.Int64(FlagExpirationHeight, 0, "block height at which the super expires, 0 means never")
+	FsAddGuardian.String(FlagExpirationTime, "", "time at which the super expires in RFC3339 format, e.g. 2023-01-02T15:04:05Z")
 	FsDeleteGuardian.String(FlagAddress, "", "bech32 encoded account address")
+	FsUpdateGuardian.String(FlagAddress, "", "bech32 encoded account address")
+	FsUpdateGuardian.String(FlagDescription, "", "new description of account")
+	FsRole.String(FlagAddress, "", "bech32 encoded account address")
+	FsRole.String(FlagRole, "", "name of the role, e.g. oracle-operator")
+	FsQuerySupers.String(FlagAccountType, "", "filter supers by account type, Genesis or Ordinary")
+	FsQuerySupers.String(FlagAddedBy, "", "filter supers by the bech32 encoded address which added them")
+	FsQueryHistory.String(FlagTarget, "", "filter history records by the bech32 encoded address they affected")
 }
@@ -3,11 +3,13 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
@@ -24,6 +26,14 @@ func GetQueryCmd() *cobra.Command {
 	}
 	txCmd.AddCommand(
 		GetCmdQuerySupers(),
+		GetCmdQuerySuper(),
+		GetCmdQuerySupersAddedBy(),
+		GetCmdQueryAccountRoles(),
+		GetCmdQueryRoleAccounts(),
+		GetCmdQueryPendingActions(),
+		GetCmdQueryPendingAction(),
+		GetCmdQueryHistory(),
+		GetCmdQueryParams(),
 	)
 	return txCmd
 }
@@ -33,7 +43,7 @@ func GetCmdQuerySupers() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "supers",
 		Short:   "Query for all supers",
-		Example: fmt.Sprintf("%s query guardian supers", version.AppName),
+		Example: fmt.Sprintf("%s query guardian supers [--account-type=Genesis] [--added-by=<address>]", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -47,7 +57,13 @@ func GetCmdQuerySupers() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.Supers(context.Background(), &types.QuerySupersRequest{Pagination: pageReq})
+			accountType, _ := cmd.Flags().GetString(FlagAccountType)
+			addedBy, _ := cmd.Flags().GetString(FlagAddedBy)
+			res, err := queryClient.Supers(context.Background(), &types.QuerySupersRequest{
+				Pagination:  pageReq,
+				AccountType: accountType,
+				AddedBy:     addedBy,
+			})
 			if err != nil {
 				return err
 			}
@@ -55,7 +71,272 @@ func GetCmdQuerySupers() *cobra.Command {
 			return clientCtx.PrintProto(res)
 		},
 	}
+	cmd.Flags().AddFlagSet(FsQuerySupers)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "all supper")
 	return cmd
 }
+
+// GetCmdQuerySuper implements the query super command.
+func GetCmdQuerySuper() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "super [address]",
+		Short:   "Query a super by address",
+		Example: fmt.Sprintf("%s query guardian super <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Super(context.Background(), &types.QuerySuperRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Super)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySupersAddedBy implements the query supers added by an account command.
+func GetCmdQuerySupersAddedBy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supers-added-by [address]",
+		Short:   "Query for all supers added by an account",
+		Example: fmt.Sprintf("%s query guardian supers-added-by <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SupersAddedBy(context.Background(), &types.QuerySupersAddedByRequest{AddedBy: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "supers added by")
+	return cmd
+}
+
+// GetCmdQueryAccountRoles implements the query account roles command.
+func GetCmdQueryAccountRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "roles [address]",
+		Short:   "Query for all roles granted to an account",
+		Example: fmt.Sprintf("%s query guardian roles <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountRoles(context.Background(), &types.QueryAccountRolesRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRoleAccounts implements the query role accounts command.
+func GetCmdQueryRoleAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "role-accounts [role]",
+		Short:   "Query for all accounts granted a role",
+		Example: fmt.Sprintf("%s query guardian role-accounts <role>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RoleAccounts(context.Background(), &types.QueryRoleAccountsRequest{Role: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "role accounts")
+	return cmd
+}
+
+// GetCmdQueryPendingActions implements the query pending actions command.
+func GetCmdQueryPendingActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-actions",
+		Short:   "Query for all super changes waiting for approvals",
+		Example: fmt.Sprintf("%s query guardian pending-actions", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingActions(context.Background(), &types.QueryPendingActionsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending actions")
+	return cmd
+}
+
+// GetCmdQueryPendingAction implements the query pending action command.
+func GetCmdQueryPendingAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-action [id]",
+		Short:   "Query a super change waiting for approvals",
+		Example: fmt.Sprintf("%s query guardian pending-action <id>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingAction(context.Background(), &types.QueryPendingActionRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Action)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryHistory implements the query history command.
+func GetCmdQueryHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "Query the change log of supers and roles",
+		Example: fmt.Sprintf("%s query guardian history [--target=<address>]", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, _ := cmd.Flags().GetString(FlagTarget)
+			if len(target) > 0 {
+				if _, err := sdk.AccAddressFromBech32(target); err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.History(context.Background(), &types.QueryHistoryRequest{Target: target, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryHistory)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current guardian parameters",
+		Example: fmt.Sprintf("%s query guardian params", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
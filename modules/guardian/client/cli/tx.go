@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -25,6 +27,10 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetCmdCreateSuper(),
 		GetCmdDeleteSuper(),
+		GetCmdUpdateSuper(),
+		GetCmdGrantRole(),
+		GetCmdRevokeRole(),
+		GetCmdApproveAction(),
 	)
 	return txCmd
 }
@@ -35,7 +41,7 @@ func GetCmdCreateSuper() *cobra.Command {
 		Use:   "add-super",
 		Short: "Add a new super",
 		Example: fmt.Sprintf(
-			"%s tx guardian add-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<added address> --description=<name>",
+			"%s tx guardian add-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<added address> --description=<name> [--expiration-height=<height>] [--expiration-time=<RFC3339 time>]",
 			version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -56,6 +62,15 @@ func GetCmdCreateSuper() *cobra.Command {
 			}
 			description, _ := cmd.Flags().GetString(FlagDescription)
 			msg := types.NewMsgAddSuper(description, pAddr, fromAddr)
+
+			msg.ExpirationHeight, _ = cmd.Flags().GetInt64(FlagExpirationHeight)
+			if expirationStr, _ := cmd.Flags().GetString(FlagExpirationTime); len(expirationStr) > 0 {
+				expiration, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+				msg.ExpirationTime = &expiration
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -104,3 +119,146 @@ func GetCmdDeleteSuper() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdUpdateSuper implements the update super command.
+func GetCmdUpdateSuper() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-super",
+		Short: "Update the description of your own super account",
+		Example: fmt.Sprintf(
+			"%s tx guardian update-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<super address> --description=<description>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			description, _ := cmd.Flags().GetString(FlagDescription)
+			msg := types.NewMsgUpdateSuper(pAddr, description, false, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsUpdateGuardian)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGrantRole implements the grant role command.
+func GetCmdGrantRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role",
+		Short: "Grant a role to an account",
+		Example: fmt.Sprintf(
+			"%s tx guardian grant-role --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<granted address> --role=<role>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			role, _ := cmd.Flags().GetString(FlagRole)
+			msg := types.NewMsgGrantRole(pAddr, role, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRole)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagRole)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRevokeRole implements the revoke role command.
+func GetCmdRevokeRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role",
+		Short: "Revoke a role from an account",
+		Example: fmt.Sprintf(
+			"%s tx guardian revoke-role --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<revoked address> --role=<role>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			role, _ := cmd.Flags().GetString(FlagRole)
+			msg := types.NewMsgRevokeRole(pAddr, role, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRole)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagRole)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdApproveAction implements the approve action command.
+func GetCmdApproveAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-action [id]",
+		Short: "Approve a pending super or role change",
+		Example: fmt.Sprintf(
+			"%s tx guardian approve-action <id> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgApproveAction(id, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
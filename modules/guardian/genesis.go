@@ -4,6 +4,9 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/irisnet/irishub/modules/guardian/keeper"
 	"github.com/irisnet/irishub/modules/guardian/types"
@@ -18,6 +21,28 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 	for _, super := range data.Supers {
 		keeper.AddSuper(ctx, super)
 	}
+	// Add role grants
+	for _, grant := range data.RoleGrants {
+		keeper.GrantRole(ctx, grant)
+	}
+
+	keeper.SetParamSet(ctx, data.Params)
+
+	// Add pending actions
+	for _, action := range data.PendingActions {
+		keeper.SetPendingAction(ctx, action)
+	}
+	keeper.SetNextActionID(ctx, data.NextActionId)
+
+	// Add history records
+	nextHistoryID := uint64(1)
+	for _, record := range data.History {
+		keeper.SetHistoryRecord(ctx, record)
+		if record.Id >= nextHistoryID {
+			nextHistoryID = record.Id + 1
+		}
+	}
+	keeper.SetNextHistoryID(ctx, nextHistoryID)
 }
 
 // ExportGenesis outputs genesis data
@@ -31,19 +56,140 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(supers)
+	var roleGrants []types.RoleGrant
+	k.IterateRoleGrants(
+		ctx,
+		func(grant types.RoleGrant) bool {
+			roleGrants = append(roleGrants, grant)
+			return false
+		},
+	)
+
+	var pendingActions []types.PendingAction
+	k.IteratePendingActions(
+		ctx,
+		func(action types.PendingAction) bool {
+			pendingActions = append(pendingActions, action)
+			return false
+		},
+	)
+
+	var history []types.HistoryRecord
+	k.IterateHistory(
+		ctx,
+		func(record types.HistoryRecord) bool {
+			history = append(history, record)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(supers, roleGrants, k.GetParamSet(ctx), pendingActions, history, k.GetNextActionID(ctx))
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data types.GenesisState) error {
+	addresses := make(map[string]bool, len(data.Supers))
+	genesisSupers := 0
 	for _, super := range data.Supers {
 		if _, err := sdk.AccAddressFromBech32(super.Address); err != nil {
 			return err
 		}
+		if addresses[super.Address] {
+			return sdkerrors.Wrapf(types.ErrSuperExists, "duplicate super %s", super.Address)
+		}
+		addresses[super.Address] = true
+		if super.AccountType == types.Genesis {
+			genesisSupers++
+		}
+	}
+	if genesisSupers == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at least one genesis super is required")
+	}
+
+	// supers can be added by an existing super or by the governance authority
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	for _, super := range data.Supers {
 		if _, err := sdk.AccAddressFromBech32(super.AddedBy); err != nil {
 			return err
 		}
+		if !addresses[super.AddedBy] && super.AddedBy != authority {
+			return sdkerrors.Wrapf(types.ErrUnknownOperator, "super %s added by unknown super %s", super.Address, super.AddedBy)
+		}
+		if super.AccountType != types.Genesis && super.AccountType != types.Ordinary {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown account type of super %s: %d", super.Address, super.AccountType)
+		}
+		if super.ExpirationHeight < 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidExpiration, "expiration height of super %s must not be negative", super.Address)
+		}
+		if super.AccountType == types.Genesis && super.HasExpiration() {
+			return sdkerrors.Wrapf(types.ErrInvalidExpiration, "genesis super %s can not expire", super.Address)
+		}
+	}
+	for _, grant := range data.RoleGrants {
+		if _, err := sdk.AccAddressFromBech32(grant.Address); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(grant.GrantedBy); err != nil {
+			return err
+		}
+		if err := types.ValidateRole(grant.Role); err != nil {
+			return err
+		}
+	}
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if data.NextActionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "next action id must be positive")
+	}
+	actionIDs := make(map[uint64]bool)
+	for _, action := range data.PendingActions {
+		if action.Id == 0 || actionIDs[action.Id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid or duplicate pending action id %d", action.Id)
+		}
+		if action.Id >= data.NextActionId {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pending action id %d must be less than the next action id %d", action.Id, data.NextActionId)
+		}
+		actionIDs[action.Id] = true
+		if !types.ValidActionType(action.ActionType) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown action type: %s", action.ActionType)
+		}
+		if action.ActionType == types.ActionGrantRole || action.ActionType == types.ActionRevokeRole {
+			if err := types.ValidateRole(action.Role); err != nil {
+				return err
+			}
+		}
+		if _, err := sdk.AccAddressFromBech32(action.Address); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(action.Proposer); err != nil {
+			return err
+		}
+		for _, approval := range action.Approvals {
+			if _, err := sdk.AccAddressFromBech32(approval); err != nil {
+				return err
+			}
+		}
+	}
+	historyIDs := make(map[uint64]bool)
+	for _, record := range data.History {
+		if record.Id == 0 || historyIDs[record.Id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid or duplicate history record id %d", record.Id)
+		}
+		historyIDs[record.Id] = true
+		if !types.ValidHistoryAction(record.Action) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown history action: %s", record.Action)
+		}
+		if _, err := sdk.AccAddressFromBech32(record.Target); err != nil {
+			return err
+		}
+		// the actor of an expiration is the chain itself
+		if len(record.Actor) > 0 {
+			if _, err := sdk.AccAddressFromBech32(record.Actor); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -2,13 +2,18 @@ package guardian_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	tmcrypto "github.com/tendermint/tendermint/crypto"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/irisnet/irishub/modules/guardian"
 	"github.com/irisnet/irishub/modules/guardian/keeper"
@@ -39,5 +44,116 @@ func TestGenesisSuite(t *testing.T) {
 func (suite *TestSuite) TestExportGenesis() {
 	exportedGenesis := guardian.ExportGenesis(suite.ctx, suite.keeper)
 	defaultGenesis := types.DefaultGenesisState()
-	suite.Equal(exportedGenesis, defaultGenesis)
+	suite.Equal(exportedGenesis.Params, defaultGenesis.Params)
+	suite.Equal(exportedGenesis.RoleGrants, defaultGenesis.RoleGrants)
+	suite.Equal(exportedGenesis.PendingActions, defaultGenesis.PendingActions)
+	suite.Equal(exportedGenesis.History, defaultGenesis.History)
+	suite.Equal(exportedGenesis.NextActionId, defaultGenesis.NextActionId)
+
+	// the test app starts with a single genesis super
+	suite.Len(exportedGenesis.Supers, 1)
+	suite.Equal(types.Genesis, exportedGenesis.Supers[0].AccountType)
+	suite.NoError(guardian.ValidateGenesis(*exportedGenesis))
+}
+
+func (suite *TestSuite) TestHistoryGenesis() {
+	addr := sdk.AccAddress(tmcrypto.AddressHash([]byte("history")))
+	record := suite.keeper.RecordHistory(suite.ctx, types.HistoryActionExpireSuper, "", addr.String(), "expired")
+	suite.Equal(uint64(1), record.Id)
+
+	exportedGenesis := guardian.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Equal([]types.HistoryRecord{record}, exportedGenesis.History)
+
+	// the history survives a restart and the ids keep increasing
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	guardian.InitGenesis(ctx, app.GuardianKeeper, *exportedGenesis)
+	suite.Equal(exportedGenesis.History, guardian.ExportGenesis(ctx, app.GuardianKeeper).History)
+	suite.Equal(uint64(2), app.GuardianKeeper.GetNextHistoryID(ctx))
+}
+
+func (suite *TestSuite) TestNextActionIDGenesis() {
+	addr := sdk.AccAddress(tmcrypto.AddressHash([]byte("action")))
+	proposer, _ := sdk.AccAddressFromBech32(guardian.ExportGenesis(suite.ctx, suite.keeper).Supers[0].Address)
+	pending := suite.keeper.SubmitAction(suite.ctx, types.NewPendingAction(0, types.ActionAddSuper, addr, "test", proposer, suite.ctx.BlockTime()))
+	executed := suite.keeper.SubmitAction(suite.ctx, types.NewPendingAction(0, types.ActionAddSuper, addr, "test", proposer, suite.ctx.BlockTime()))
+	suite.keeper.DeletePendingAction(suite.ctx, executed)
+
+	exportedGenesis := guardian.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Equal([]types.PendingAction{pending}, exportedGenesis.PendingActions)
+	suite.Equal(executed.Id+1, exportedGenesis.NextActionId)
+	suite.NoError(guardian.ValidateGenesis(*exportedGenesis))
+
+	// the ids of the actions which are no longer pending are not reused after a restart
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	guardian.InitGenesis(ctx, app.GuardianKeeper, *exportedGenesis)
+	suite.Equal(executed.Id+1, app.GuardianKeeper.GetNextActionID(ctx))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress(tmcrypto.AddressHash([]byte("genesis"))),
+		sdk.AccAddress(tmcrypto.AddressHash([]byte("ordinary"))),
+		sdk.AccAddress(tmcrypto.AddressHash([]byte("unknown"))),
+	}
+	genesisSuper := types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0])
+	ordinarySuper := types.NewSuper("test", types.Ordinary, addrs[1], addrs[0])
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	withSupers := func(supers ...types.Super) types.GenesisState {
+		genesis := types.DefaultGenesisState()
+		genesis.Supers = supers
+		return *genesis
+	}
+	unknownType := ordinarySuper
+	unknownType.AccountType = types.AccountType(2)
+
+	withHistory := func(records ...types.HistoryRecord) types.GenesisState {
+		genesis := withSupers(genesisSuper)
+		genesis.History = records
+		return genesis
+	}
+	record := types.HistoryRecord{Id: 1, Action: types.HistoryActionAddSuper, Actor: addrs[0].String(), Target: addrs[1].String()}
+	unknownAction := record
+	unknownAction.Action = "unknown"
+
+	withPendingActions := func(nextActionID uint64, actions ...types.PendingAction) types.GenesisState {
+		genesis := withSupers(genesisSuper)
+		genesis.PendingActions = actions
+		genesis.NextActionId = nextActionID
+		return genesis
+	}
+	action := types.NewPendingAction(2, types.ActionAddSuper, addrs[1], "test", addrs[0], time.Now())
+
+	tests := []struct {
+		name       string
+		expectPass bool
+		genesis    types.GenesisState
+	}{
+		{"pass", true, withSupers(genesisSuper, ordinarySuper)},
+		{"added by authority", true, withSupers(genesisSuper, types.NewSuper("test", types.Ordinary, addrs[1], authority))},
+		{"empty", false, *types.DefaultGenesisState()},
+		{"no genesis super", false, withSupers(types.NewSuper("test", types.Ordinary, addrs[1], addrs[1]))},
+		{"duplicate super", false, withSupers(genesisSuper, genesisSuper)},
+		{"unknown account type", false, withSupers(genesisSuper, unknownType)},
+		{"dangling added by", false, withSupers(genesisSuper, types.NewSuper("test", types.Ordinary, addrs[1], addrs[2]))},
+		{"history", true, withHistory(record)},
+		{"duplicate history id", false, withHistory(record, record)},
+		{"unknown history action", false, withHistory(unknownAction)},
+		{"pending action", true, withPendingActions(3, action)},
+		{"pending action id not less than the next id", false, withPendingActions(2, action)},
+		{"zero next action id", false, withPendingActions(0)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := guardian.ValidateGenesis(tc.genesis)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
 }
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// SubmitAction stores the action as pending with a new id and a deadline
+// derived from the approval period
+func (k Keeper) SubmitAction(ctx sdk.Context, action types.PendingAction) types.PendingAction {
+	params := k.GetParamSet(ctx)
+
+	action.Id = k.GetNextActionID(ctx)
+	action.Deadline = ctx.BlockTime().Add(params.ApprovalPeriod)
+
+	k.SetPendingAction(ctx, action)
+	k.SetNextActionID(ctx, action.Id+1)
+	return action
+}
+
+// SetPendingAction stores the pending action and its deadline in the queue
+func (k Keeper) SetPendingAction(ctx sdk.Context, action types.PendingAction) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&action)
+	store.Set(types.GetPendingActionKey(action.Id), bz)
+	store.Set(types.GetPendingActionQueueKey(action.Deadline, action.Id), sdk.Uint64ToBigEndian(action.Id))
+}
+
+// GetPendingAction retrieves the pending action by the specified id
+func (k Keeper) GetPendingAction(ctx sdk.Context, id uint64) (action types.PendingAction, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetPendingActionKey(id)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &action)
+		return action, true
+	}
+	return action, false
+}
+
+// DeletePendingAction deletes the pending action and removes it from the queue
+func (k Keeper) DeletePendingAction(ctx sdk.Context, action types.PendingAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingActionKey(action.Id))
+	store.Delete(types.GetPendingActionQueueKey(action.Deadline, action.Id))
+}
+
+// IteratePendingActions iterates through all pending actions
+func (k Keeper) IteratePendingActions(
+	ctx sdk.Context,
+	op func(action types.PendingAction) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingActionKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var action types.PendingAction
+		k.cdc.MustUnmarshal(iterator.Value(), &action)
+
+		if stop := op(action); stop {
+			break
+		}
+	}
+}
+
+// GetNextActionID returns the id of the next pending action
+func (k Keeper) GetNextActionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextActionIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextActionID sets the id of the next pending action
+func (k Keeper) SetNextActionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextActionIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// PruneExpiredActions deletes all the pending actions whose deadline has passed
+// and returns them
+func (k Keeper) PruneExpiredActions(ctx sdk.Context) (expired []types.PendingAction) {
+	store := ctx.KVStore(k.storeKey)
+
+	// the deadline itself is still open for approvals
+	iterator := store.Iterator(
+		types.PendingActionQueueKey,
+		types.GetPendingActionQueuePrefix(ctx.BlockTime()),
+	)
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		action, found := k.GetPendingAction(ctx, id)
+		if !found {
+			continue
+		}
+		k.DeletePendingAction(ctx, action)
+		expired = append(expired, action)
+	}
+	return expired
+}
+
+// ValidateAction checks if the membership or role change of the action can be applied
+func (k Keeper) ValidateAction(ctx sdk.Context, action types.PendingAction) error {
+	address, err := sdk.AccAddressFromBech32(action.Address)
+	if err != nil {
+		return err
+	}
+
+	switch action.ActionType {
+	case types.ActionAddSuper:
+		if _, found := k.GetSuper(ctx, address); found {
+			return sdkerrors.Wrap(types.ErrSuperExists, action.Address)
+		}
+		if action.ExpirationHeight > 0 && action.ExpirationHeight <= ctx.BlockHeight() {
+			return sdkerrors.Wrapf(types.ErrInvalidExpiration, "expiration height %d must be after the current height %d", action.ExpirationHeight, ctx.BlockHeight())
+		}
+		if action.ExpirationTime != nil && !action.ExpirationTime.After(ctx.BlockTime()) {
+			return sdkerrors.Wrapf(types.ErrInvalidExpiration, "expiration time %s must be after the current block time %s", action.ExpirationTime, ctx.BlockTime())
+		}
+	case types.ActionDeleteSuper:
+		super, found := k.GetSuper(ctx, address)
+		if !found {
+			return sdkerrors.Wrap(types.ErrUnknownSuper, action.Address)
+		}
+		if super.GetAccountType() == types.Genesis {
+			return sdkerrors.Wrap(types.ErrDeleteGenesisSuper, action.Address)
+		}
+	case types.ActionGrantRole:
+		if err := types.ValidateRole(action.Role); err != nil {
+			return err
+		}
+		if k.HasRole(ctx, address, action.Role) {
+			return sdkerrors.Wrapf(types.ErrRoleExists, "%s: %s", action.Address, action.Role)
+		}
+	case types.ActionRevokeRole:
+		if !k.HasRole(ctx, address, action.Role) {
+			return sdkerrors.Wrapf(types.ErrUnknownRole, "%s: %s", action.Address, action.Role)
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown action type: %s", action.ActionType)
+	}
+	return nil
+}
+
+// ExecuteAction applies the membership or role change of the action and emits the
+// corresponding add_super, delete_super, grant_role or revoke_role event
+func (k Keeper) ExecuteAction(ctx sdk.Context, action types.PendingAction) error {
+	if err := k.ValidateAction(ctx, action); err != nil {
+		return err
+	}
+
+	address, _ := sdk.AccAddressFromBech32(action.Address)
+	switch action.ActionType {
+	case types.ActionAddSuper:
+		proposer, err := sdk.AccAddressFromBech32(action.Proposer)
+		if err != nil {
+			return err
+		}
+		super := types.NewSuper(action.Description, types.Ordinary, address, proposer)
+		super.ExpirationHeight = action.ExpirationHeight
+		super.ExpirationTime = action.ExpirationTime
+		k.AddSuper(ctx, super)
+		k.RecordHistory(ctx, types.HistoryActionAddSuper, action.Proposer, action.Address, action.Description)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAddSuper,
+				sdk.NewAttribute(types.AttributeKeySuperAddress, action.Address),
+				sdk.NewAttribute(types.AttributeKeyAddedBy, action.Proposer),
+				sdk.NewAttribute(types.AttributeKeyActionID, sdk.NewUint(action.Id).String()),
+			),
+		)
+	case types.ActionDeleteSuper:
+		k.DeleteSuper(ctx, address)
+		k.RecordHistory(ctx, types.HistoryActionDeleteSuper, action.Proposer, action.Address, "")
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeleteSuper,
+				sdk.NewAttribute(types.AttributeKeySuperAddress, action.Address),
+				sdk.NewAttribute(types.AttributeKeyDeletedBy, action.Proposer),
+				sdk.NewAttribute(types.AttributeKeyActionID, sdk.NewUint(action.Id).String()),
+			),
+		)
+	case types.ActionGrantRole:
+		proposer, err := sdk.AccAddressFromBech32(action.Proposer)
+		if err != nil {
+			return err
+		}
+		k.GrantRole(ctx, types.NewRoleGrant(address, action.Role, proposer))
+		k.RecordHistory(ctx, types.HistoryActionGrantRole, action.Proposer, action.Address, action.Role)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeGrantRole,
+				sdk.NewAttribute(types.AttributeKeySuperAddress, action.Address),
+				sdk.NewAttribute(types.AttributeKeyRole, action.Role),
+				sdk.NewAttribute(types.AttributeKeyGrantedBy, action.Proposer),
+				sdk.NewAttribute(types.AttributeKeyActionID, sdk.NewUint(action.Id).String()),
+			),
+		)
+	case types.ActionRevokeRole:
+		k.RevokeRole(ctx, address, action.Role)
+		k.RecordHistory(ctx, types.HistoryActionRevokeRole, action.Proposer, action.Address, action.Role)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRevokeRole,
+				sdk.NewAttribute(types.AttributeKeySuperAddress, action.Address),
+				sdk.NewAttribute(types.AttributeKeyRole, action.Role),
+				sdk.NewAttribute(types.AttributeKeyRevokedBy, action.Proposer),
+				sdk.NewAttribute(types.AttributeKeyActionID, sdk.NewUint(action.Id).String()),
+			),
+		)
+	}
+	return nil
+}
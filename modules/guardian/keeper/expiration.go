@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// insertExpirationQueue adds the super to the expiration queues if it has an expiration
+func (k Keeper) insertExpirationQueue(ctx sdk.Context, super types.Super, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if super.ExpirationHeight > 0 {
+		store.Set(types.GetSuperExpirationHeightKey(super.ExpirationHeight, address), address.Bytes())
+	}
+	if super.ExpirationTime != nil {
+		store.Set(types.GetSuperExpirationTimeKey(*super.ExpirationTime, address), address.Bytes())
+	}
+}
+
+// removeFromExpirationQueue removes the super from the expiration queues
+func (k Keeper) removeFromExpirationQueue(ctx sdk.Context, super types.Super, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if super.ExpirationHeight > 0 {
+		store.Delete(types.GetSuperExpirationHeightKey(super.ExpirationHeight, address))
+	}
+	if super.ExpirationTime != nil {
+		store.Delete(types.GetSuperExpirationTimeKey(*super.ExpirationTime, address))
+	}
+}
+
+// IterateExpiredSupers iterates through the addresses of the supers which expire
+// at or before the given block height or time
+func (k Keeper) IterateExpiredSupers(
+	ctx sdk.Context,
+	height int64,
+	blockTime time.Time,
+	op func(address sdk.AccAddress) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	heightIterator := store.Iterator(
+		types.SuperExpirationHeightKey,
+		sdk.PrefixEndBytes(types.GetSuperExpirationHeightPrefix(height)),
+	)
+	defer heightIterator.Close()
+
+	for ; heightIterator.Valid(); heightIterator.Next() {
+		if stop := op(heightIterator.Value()); stop {
+			return
+		}
+	}
+
+	timeIterator := store.Iterator(
+		types.SuperExpirationTimeKey,
+		sdk.PrefixEndBytes(types.GetSuperExpirationTimePrefix(blockTime)),
+	)
+	defer timeIterator.Close()
+
+	for ; timeIterator.Valid(); timeIterator.Next() {
+		if stop := op(timeIterator.Value()); stop {
+			return
+		}
+	}
+}
+
+// PruneExpiredSupers deletes all the supers which have expired by the current block
+// and returns them
+func (k Keeper) PruneExpiredSupers(ctx sdk.Context) (expired []types.Super) {
+	var addresses []sdk.AccAddress
+	seen := make(map[string]bool)
+	k.IterateExpiredSupers(
+		ctx,
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+		func(address sdk.AccAddress) bool {
+			// a super with both an expiration height and time can appear twice
+			if !seen[address.String()] {
+				seen[address.String()] = true
+				addresses = append(addresses, address)
+			}
+			return false
+		},
+	)
+
+	for _, address := range addresses {
+		super, found := k.getSuper(ctx, address)
+		if !found {
+			continue
+		}
+		k.DeleteSuper(ctx, address)
+		expired = append(expired, super)
+	}
+	return expired
+}
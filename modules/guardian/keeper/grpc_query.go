@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -19,13 +20,95 @@ func (k Keeper) Supers(c context.Context, req *types.QuerySupersRequest) (*types
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
+
+	var accountType *types.AccountType
+	if len(req.AccountType) > 0 {
+		value, ok := types.AccountType_value[req.AccountType]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid account type: %s", req.AccountType)
+		}
+		at := types.AccountType(value)
+		accountType = &at
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	var supers []types.Super
-	store := ctx.KVStore(k.storeKey)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSupersSubspaceKey())
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+	// the index by added_by stores the addresses of the supers as values
+	indexed := len(req.AddedBy) > 0
+	if indexed {
+		addedBy, err := sdk.AccAddressFromBech32(req.AddedBy)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid added_by address: %v", err)
+		}
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSupersAddedBySubspaceKey(addedBy))
+	}
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var super types.Super
-		k.cdc.MustUnmarshal(value, &super)
+		if indexed {
+			var found bool
+			if super, found = k.GetSuper(ctx, value); !found {
+				return false, status.Errorf(codes.Internal, "super not found: %s", sdk.AccAddress(value))
+			}
+		} else {
+			k.cdc.MustUnmarshal(value, &super)
+		}
+
+		if accountType != nil && super.AccountType != *accountType {
+			return false, nil
+		}
+
+		if accumulate {
+			supers = append(supers, super)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QuerySupersResponse{Supers: supers, Pagination: pageRes}, nil
+}
+
+// Super implements the Query/Super gRPC method
+func (k Keeper) Super(c context.Context, req *types.QuerySuperRequest) (*types.QuerySuperResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	super, found := k.GetSuper(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "super %s not found", req.Address)
+	}
+
+	return &types.QuerySuperResponse{Super: super}, nil
+}
+
+// SupersAddedBy implements the Query/SupersAddedBy gRPC method
+func (k Keeper) SupersAddedBy(c context.Context, req *types.QuerySupersAddedByRequest) (*types.QuerySupersAddedByResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	addedBy, err := sdk.AccAddressFromBech32(req.AddedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var supers []types.Super
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSupersAddedBySubspaceKey(addedBy))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		super, found := k.GetSuper(ctx, value)
+		if !found {
+			return status.Errorf(codes.Internal, "super not found: %s", sdk.AccAddress(value))
+		}
 		supers = append(supers, super)
 		return nil
 	})
@@ -33,5 +116,132 @@ func (k Keeper) Supers(c context.Context, req *types.QuerySupersRequest) (*types
 		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
 	}
 
-	return &types.QuerySupersResponse{Supers: supers, Pagination: pageRes}, nil
+	return &types.QuerySupersAddedByResponse{Supers: supers, Pagination: pageRes}, nil
+}
+
+// AccountRoles implements the Query/AccountRoles gRPC method
+func (k Keeper) AccountRoles(c context.Context, req *types.QueryAccountRolesRequest) (*types.QueryAccountRolesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	grants := []types.RoleGrant{}
+	k.IterateAccountRoles(ctx, address, func(grant types.RoleGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	return &types.QueryAccountRolesResponse{Grants: grants}, nil
+}
+
+// RoleAccounts implements the Query/RoleAccounts gRPC method
+func (k Keeper) RoleAccounts(c context.Context, req *types.QueryRoleAccountsRequest) (*types.QueryRoleAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateRole(req.Role); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var grants []types.RoleGrant
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRoleAccountsSubspaceKey(req.Role))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		grant, found := k.GetRoleGrant(ctx, value, req.Role)
+		if !found {
+			return status.Errorf(codes.Internal, "role grant not found: %s", sdk.AccAddress(value))
+		}
+		grants = append(grants, grant)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryRoleAccountsResponse{Grants: grants, Pagination: pageRes}, nil
+}
+
+// PendingActions implements the Query/PendingActions gRPC method
+func (k Keeper) PendingActions(c context.Context, req *types.QueryPendingActionsRequest) (*types.QueryPendingActionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var actions []types.PendingAction
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingActionKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var action types.PendingAction
+		k.cdc.MustUnmarshal(value, &action)
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryPendingActionsResponse{Actions: actions, Pagination: pageRes}, nil
+}
+
+// PendingAction implements the Query/PendingAction gRPC method
+func (k Keeper) PendingAction(c context.Context, req *types.QueryPendingActionRequest) (*types.QueryPendingActionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	action, found := k.GetPendingAction(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pending action %d not found", req.Id)
+	}
+
+	return &types.QueryPendingActionResponse{Action: action}, nil
+}
+
+// History implements the Query/History gRPC method
+func (k Keeper) History(c context.Context, req *types.QueryHistoryRequest) (*types.QueryHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Target) > 0 {
+		if _, err := sdk.AccAddressFromBech32(req.Target); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid target address: %v", err)
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var records []types.HistoryRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HistoryKey)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var record types.HistoryRecord
+		k.cdc.MustUnmarshal(value, &record)
+
+		if len(req.Target) > 0 && record.Target != req.Target {
+			return false, nil
+		}
+
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParamSet(ctx)}, nil
 }
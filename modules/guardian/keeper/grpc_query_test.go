@@ -5,7 +5,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/irisnet/irishub/modules/guardian/keeper"
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
 
@@ -25,6 +28,155 @@ func (suite *KeeperTestSuite) TestGRPCQuerySupers() {
 
 	supersResp, err := queryClient.Supers(gocontext.Background(), &types.QuerySupersRequest{})
 	suite.Require().NoError(err)
+	suite.Len(supersResp.Supers, len(suite.genesisSupers)+1)
+	suite.Contains(supersResp.Supers, guardian)
+
+	superResp, err := queryClient.Super(gocontext.Background(), &types.QuerySuperRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Equal(guardian, superResp.Super)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySupersFilter() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, addr3 := testdata.KeyTestPubAddr()
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, addr, addr))
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("test", types.Ordinary, addr2, addr))
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("test", types.Ordinary, addr3, addr2))
+
+	supersResp, err := queryClient.Supers(gocontext.Background(), &types.QuerySupersRequest{AccountType: types.Ordinary.String()})
+	suite.Require().NoError(err)
+	suite.Len(supersResp.Supers, 2)
+
+	supersResp, err = queryClient.Supers(gocontext.Background(), &types.QuerySupersRequest{AddedBy: addr.String()})
+	suite.Require().NoError(err)
+	suite.Len(supersResp.Supers, 2)
+
+	supersResp, err = queryClient.Supers(gocontext.Background(), &types.QuerySupersRequest{
+		AccountType: types.Ordinary.String(),
+		AddedBy:     addr.String(),
+		Pagination:  &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
 	suite.Len(supersResp.Supers, 1)
-	suite.Equal(guardian, supersResp.Supers[0])
+	suite.Equal(addr2.String(), supersResp.Supers[0].Address)
+	suite.Equal(uint64(1), supersResp.Pagination.Total)
+
+	_, err = queryClient.Supers(gocontext.Background(), &types.QuerySupersRequest{AccountType: "Unknown"})
+	suite.Require().Error(err)
+
+	_, err = queryClient.Super(gocontext.Background(), &types.QuerySuperRequest{Address: addr3.String()})
+	suite.Require().NoError(err)
+
+	addedByResp, err := queryClient.SupersAddedBy(gocontext.Background(), &types.QuerySupersAddedByRequest{AddedBy: addr2.String()})
+	suite.Require().NoError(err)
+	suite.Len(addedByResp.Supers, 1)
+	suite.Equal(addr3.String(), addedByResp.Supers[0].Address)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryRoles() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	app.GuardianKeeper.GrantRole(ctx, types.NewRoleGrant(addr, types.RoleOracleOperator, addr2))
+	app.GuardianKeeper.GrantRole(ctx, types.NewRoleGrant(addr, types.RoleTokenAdmin, addr2))
+	app.GuardianKeeper.GrantRole(ctx, types.NewRoleGrant(addr2, types.RoleOracleOperator, addr2))
+
+	rolesResp, err := queryClient.AccountRoles(gocontext.Background(), &types.QueryAccountRolesRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Len(rolesResp.Grants, 2)
+
+	accountsResp, err := queryClient.RoleAccounts(gocontext.Background(), &types.QueryRoleAccountsRequest{Role: types.RoleOracleOperator})
+	suite.Require().NoError(err)
+	suite.Len(accountsResp.Grants, 2)
+
+	accountsResp, err = queryClient.RoleAccounts(gocontext.Background(), &types.QueryRoleAccountsRequest{Role: types.RoleTokenAdmin})
+	suite.Require().NoError(err)
+	suite.Len(accountsResp.Grants, 1)
+	suite.Equal(addr.String(), accountsResp.Grants[0].Address)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryPendingActions() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	paramsResp, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(types.DefaultParams(), paramsResp.Params)
+
+	action := app.GuardianKeeper.SubmitAction(
+		ctx,
+		types.NewPendingAction(0, types.ActionAddSuper, addr, "test", addr2, ctx.BlockTime()),
+	)
+
+	actionsResp, err := queryClient.PendingActions(gocontext.Background(), &types.QueryPendingActionsRequest{})
+	suite.Require().NoError(err)
+	suite.Len(actionsResp.Actions, 1)
+
+	actionResp, err := queryClient.PendingAction(gocontext.Background(), &types.QueryPendingActionRequest{Id: action.Id})
+	suite.Require().NoError(err)
+	suite.Equal(action.Address, actionResp.Action.Address)
+	suite.Equal(action.Approvals, actionResp.Action.Approvals)
+
+	_, err = queryClient.PendingAction(gocontext.Background(), &types.QueryPendingActionRequest{Id: action.Id + 1})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryHistory() {
+	app, ctx := suite.app, suite.ctx.WithBlockHeight(10)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	app.GuardianKeeper.AddSuper(ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	msgServer := keeper.NewMsgServerImpl(app.GuardianKeeper)
+	_, err := msgServer.AddSuper(sdk.WrapSDKContext(ctx), types.NewMsgAddSuper("test", addrs[1], addrs[0]))
+	suite.Require().NoError(err)
+	_, err = msgServer.GrantRole(sdk.WrapSDKContext(ctx), types.NewMsgGrantRole(addrs[2], types.RoleTokenAdmin, addrs[0]))
+	suite.Require().NoError(err)
+	_, err = msgServer.DeleteSuper(sdk.WrapSDKContext(ctx), types.NewMsgDeleteSuper(addrs[1], addrs[0]))
+	suite.Require().NoError(err)
+
+	historyResp, err := queryClient.History(gocontext.Background(), &types.QueryHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Len(historyResp.Records, 3)
+	suite.Equal(types.HistoryActionGrantRole, historyResp.Records[1].Action)
+	suite.Equal(types.RoleTokenAdmin, historyResp.Records[1].Description)
+
+	// the records of a deleted super are kept
+	historyResp, err = queryClient.History(gocontext.Background(), &types.QueryHistoryRequest{Target: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Len(historyResp.Records, 2)
+	suite.Equal(types.HistoryActionAddSuper, historyResp.Records[0].Action)
+	suite.Equal(types.HistoryActionDeleteSuper, historyResp.Records[1].Action)
+	suite.Equal(addrs[0].String(), historyResp.Records[1].Actor)
+	suite.Equal(int64(10), historyResp.Records[1].Height)
+
+	historyResp, err = queryClient.History(gocontext.Background(), &types.QueryHistoryRequest{
+		Target:     addrs[1].String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Len(historyResp.Records, 1)
+	suite.Equal(uint64(2), historyResp.Pagination.Total)
+
+	_, err = queryClient.History(gocontext.Background(), &types.QueryHistoryRequest{Target: "invalid"})
+	suite.Require().Error(err)
 }
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// RecordHistory appends a history record of a guardian mutation at the current block
+func (k Keeper) RecordHistory(ctx sdk.Context, action, actor, target, description string) types.HistoryRecord {
+	record := types.HistoryRecord{
+		Id:          k.GetNextHistoryID(ctx),
+		Action:      action,
+		Actor:       actor,
+		Target:      target,
+		Height:      ctx.BlockHeight(),
+		Time:        ctx.BlockTime(),
+		Description: description,
+	}
+	k.SetHistoryRecord(ctx, record)
+	k.SetNextHistoryID(ctx, record.Id+1)
+	return record
+}
+
+// SetHistoryRecord stores the history record
+func (k Keeper) SetHistoryRecord(ctx sdk.Context, record types.HistoryRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetHistoryKey(record.Id), bz)
+}
+
+// IterateHistory iterates through all history records in the order of their ids
+func (k Keeper) IterateHistory(
+	ctx sdk.Context,
+	op func(record types.HistoryRecord) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.HistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.HistoryRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if stop := op(record); stop {
+			break
+		}
+	}
+}
+
+// GetNextHistoryID returns the id of the next history record
+func (k Keeper) GetNextHistoryID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextHistoryIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextHistoryID sets the id of the next history record
+func (k Keeper) SetNextHistoryID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextHistoryIDKey, sdk.Uint64ToBigEndian(id))
+}
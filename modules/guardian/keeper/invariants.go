@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// RegisterInvariants registers all guardian invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "genesis-super", GenesisSuperInvariant(k))
+}
+
+// AllInvariants runs all invariants of the guardian module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return GenesisSuperInvariant(k)(ctx)
+	}
+}
+
+// GenesisSuperInvariant checks that at least one genesis super exists
+func GenesisSuperInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		genesisSupers := k.CountGenesisSupers(ctx)
+
+		broken := genesisSupers == 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "genesis super",
+			fmt.Sprintf("\tgenesis supers: %d, at least one is required\n", genesisSupers),
+		), broken
+	}
+}
@@ -8,21 +8,40 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
 
 // Keeper of the guardian store
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey storetypes.StoreKey
+	cdc        codec.Codec
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+
+	// the address capable of executing a MsgAddSuper or MsgDeleteSuper message
+	// without the approval of the genesis supers. Typically, this should be the
+	// x/gov module account.
+	authority string
 }
 
 // NewKeeper returns a guardian keeper
-func NewKeeper(cdc codec.Codec, key storetypes.StoreKey) Keeper {
+func NewKeeper(
+	cdc codec.Codec,
+	key storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
+	authority string,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	keeper := Keeper{
-		storeKey: key,
-		cdc:      cdc,
+		storeKey:   key,
+		cdc:        cdc,
+		paramSpace: paramSpace,
+		authority:  authority,
 	}
 	return keeper
 }
@@ -37,17 +56,37 @@ func (k Keeper) AddSuper(ctx sdk.Context, super types.Super) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&super)
 	address, _ := sdk.AccAddressFromBech32(super.Address)
+	if existing, found := k.getSuper(ctx, address); found {
+		k.removeFromExpirationQueue(ctx, existing, address)
+		k.removeAddedByIndex(ctx, existing, address)
+	}
 	store.Set(types.GetSuperKey(address), bz)
+	k.insertExpirationQueue(ctx, super, address)
+	k.setAddedByIndex(ctx, super, address)
 }
 
 // DeleteSuper delete the stored super
 func (k Keeper) DeleteSuper(ctx sdk.Context, address sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
+	if super, found := k.getSuper(ctx, address); found {
+		k.removeFromExpirationQueue(ctx, super, address)
+		k.removeAddedByIndex(ctx, super, address)
+	}
 	store.Delete(types.GetSuperKey(address))
 }
 
-// GetSuper retrieves the super by specified address
+// GetSuper retrieves the super by specified address, a super which has expired by the
+// current block is not found even if it is not pruned yet
 func (k Keeper) GetSuper(ctx sdk.Context, addr sdk.AccAddress) (super types.Super, found bool) {
+	super, found = k.getSuper(ctx, addr)
+	if !found || super.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+		return types.Super{}, false
+	}
+	return super, true
+}
+
+// getSuper retrieves the stored super by specified address regardless of its expiration
+func (k Keeper) getSuper(ctx sdk.Context, addr sdk.AccAddress) (super types.Super, found bool) {
 	store := ctx.KVStore(k.storeKey)
 	if bz := store.Get(types.GetSuperKey(addr)); bz != nil {
 		k.cdc.MustUnmarshal(bz, &super)
@@ -76,7 +115,76 @@ func (k Keeper) IterateSupers(
 	}
 }
 
+// CountGenesisSupers returns the number of the genesis supers
+func (k Keeper) CountGenesisSupers(ctx sdk.Context) (count int) {
+	k.IterateSupers(ctx, func(super types.Super) bool {
+		if super.AccountType == types.Genesis {
+			count++
+		}
+		return false
+	})
+	return count
+}
+
+// IterateSupersAddedBy iterates through all supers added by the specified address
+func (k Keeper) IterateSupersAddedBy(
+	ctx sdk.Context,
+	addedBy sdk.AccAddress,
+	op func(super types.Super) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetSupersAddedBySubspaceKey(addedBy))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		super, found := k.GetSuper(ctx, iterator.Value())
+		if !found {
+			continue
+		}
+
+		if stop := op(super); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) setAddedByIndex(ctx sdk.Context, super types.Super, address sdk.AccAddress) {
+	addedBy, err := sdk.AccAddressFromBech32(super.AddedBy)
+	if err != nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSuperAddedByKey(addedBy, address), address.Bytes())
+}
+
+func (k Keeper) removeAddedByIndex(ctx sdk.Context, super types.Super, address sdk.AccAddress) {
+	addedBy, err := sdk.AccAddressFromBech32(super.AddedBy)
+	if err != nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSuperAddedByKey(addedBy, address))
+}
+
 func (k Keeper) Authorized(ctx sdk.Context, addr sdk.AccAddress) bool {
 	_, found := k.GetSuper(ctx, addr)
 	return found
 }
+
+// GetAuthority returns the guardian module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParamSet returns guardian params from the global param store
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParamSet sets guardian params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
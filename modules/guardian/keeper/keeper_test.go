@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -13,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/irisnet/irishub/modules/guardian/keeper"
 	"github.com/irisnet/irishub/modules/guardian/types"
@@ -39,6 +42,9 @@ type KeeperTestSuite struct {
 	ctx    sdk.Context
 	keeper keeper.Keeper
 	app    *simapp.SimApp
+
+	// supers of the test app genesis state
+	genesisSupers []types.Super
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
@@ -48,6 +54,12 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.cdc = app.LegacyAmino()
 	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
 	suite.keeper = app.GuardianKeeper
+
+	suite.genesisSupers = nil
+	suite.keeper.IterateSupers(suite.ctx, func(super types.Super) bool {
+		suite.genesisSupers = append(suite.genesisSupers, super)
+		return false
+	})
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -71,7 +83,7 @@ func (suite *KeeperTestSuite) TestAddSuper() {
 		},
 	)
 
-	suite.Equal(1, len(supers))
+	suite.Equal(len(suite.genesisSupers)+1, len(supers))
 	suite.Contains(supers, super)
 }
 
@@ -90,6 +102,81 @@ func (suite *KeeperTestSuite) TestDeleteSuper() {
 	suite.False(found)
 }
 
+func (suite *KeeperTestSuite) TestPruneExpiredSupers() {
+	super := types.NewSuper("test", types.Ordinary, addrs[0], addrs[1])
+	super.ExpirationHeight = 5
+	suite.keeper.AddSuper(suite.ctx, super)
+
+	// replacing the super moves it in the expiration queue
+	super.ExpirationHeight = 10
+	suite.keeper.AddSuper(suite.ctx, super)
+
+	suite.Empty(suite.keeper.PruneExpiredSupers(suite.ctx.WithBlockHeight(5)))
+	expired := suite.keeper.PruneExpiredSupers(suite.ctx.WithBlockHeight(10))
+	suite.Len(expired, 1)
+	suite.True(super.Equal(expired[0]))
+
+	_, found := suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestGetExpiredSuper() {
+	super := types.NewSuper("test", types.Ordinary, addrs[0], addrs[1])
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+	super.ExpirationTime = &expiration
+	suite.keeper.AddSuper(suite.ctx, super)
+
+	suite.True(suite.keeper.Authorized(suite.ctx.WithBlockTime(expiration.Add(-time.Second)), addrs[0]))
+
+	// an expired super is not found before it is pruned at the end of the block
+	ctx := suite.ctx.WithBlockTime(expiration)
+	_, found := suite.keeper.GetSuper(ctx, addrs[0])
+	suite.False(found)
+	suite.False(suite.keeper.Authorized(ctx, addrs[0]))
+
+	expired := suite.keeper.PruneExpiredSupers(ctx)
+	suite.Len(expired, 1)
+	suite.True(super.Equal(expired[0]))
+}
+
+func (suite *KeeperTestSuite) TestSupersAddedBy() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("test", types.Ordinary, addrs[0], addrs[2]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("test", types.Ordinary, addrs[1], addrs[2]))
+
+	addedBy := func(addr sdk.AccAddress) (supers []types.Super) {
+		suite.keeper.IterateSupersAddedBy(suite.ctx, addr, func(super types.Super) bool {
+			supers = append(supers, super)
+			return false
+		})
+		return supers
+	}
+	suite.Len(addedBy(addrs[2]), 2)
+
+	// replacing the super moves it in the index
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("test", types.Ordinary, addrs[1], addrs[0]))
+	suite.Len(addedBy(addrs[2]), 1)
+	suite.Len(addedBy(addrs[0]), 1)
+
+	suite.keeper.DeleteSuper(suite.ctx, addrs[0])
+	suite.Empty(addedBy(addrs[2]))
+
+	// the migration rebuilds the index of the existing supers
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Delete(types.GetSuperAddedByKey(addrs[0], addrs[1]))
+	suite.Empty(addedBy(addrs[0]))
+
+	suite.NoError(keeper.NewMigrator(suite.keeper).Migrate2to3(suite.ctx))
+	supers := addedBy(addrs[0])
+	suite.Len(supers, 1)
+	suite.Equal(addrs[1].String(), supers[0].Address)
+
+	// and fails on a super which can't be indexed
+	invalid := types.NewSuper("test", types.Ordinary, addrs[2], addrs[0])
+	invalid.AddedBy = "invalid"
+	store.Set(types.GetSuperKey(addrs[2]), suite.app.AppCodec().MustMarshal(&invalid))
+	suite.Error(keeper.NewMigrator(suite.keeper).Migrate2to3(suite.ctx))
+}
+
 func (suite *KeeperTestSuite) TestQuerySupers() {
 	super := types.NewSuper("test", types.Genesis, addrs[0], addrs[1])
 	suite.keeper.AddSuper(suite.ctx, super)
@@ -101,10 +188,254 @@ func (suite *KeeperTestSuite) TestQuerySupers() {
 
 	err := suite.cdc.UnmarshalJSON(res, &supers)
 	suite.NoError(err)
-	suite.Len(supers, 1)
+	suite.Len(supers, len(suite.genesisSupers)+1)
 	suite.Contains(supers, super)
 }
 
+func (suite *KeeperTestSuite) TestGrantRole() {
+	grant := types.NewRoleGrant(addrs[0], types.RoleOracleOperator, addrs[1])
+
+	suite.False(suite.keeper.HasRole(suite.ctx, addrs[0], types.RoleOracleOperator))
+	suite.keeper.GrantRole(suite.ctx, grant)
+	suite.True(suite.keeper.HasRole(suite.ctx, addrs[0], types.RoleOracleOperator))
+	suite.False(suite.keeper.HasRole(suite.ctx, addrs[0], types.RoleTokenAdmin))
+	suite.False(suite.keeper.HasRole(suite.ctx, addrs[1], types.RoleOracleOperator))
+
+	storedGrant, found := suite.keeper.GetRoleGrant(suite.ctx, addrs[0], types.RoleOracleOperator)
+	suite.True(found)
+	suite.Equal(grant, storedGrant)
+
+	suite.keeper.GrantRole(suite.ctx, types.NewRoleGrant(addrs[0], types.RoleTokenAdmin, addrs[1]))
+	suite.keeper.GrantRole(suite.ctx, types.NewRoleGrant(addrs[2], types.RoleOracleOperator, addrs[1]))
+
+	var grants []types.RoleGrant
+	suite.keeper.IterateAccountRoles(
+		suite.ctx,
+		addrs[0],
+		func(grant types.RoleGrant) bool {
+			grants = append(grants, grant)
+			return false
+		},
+	)
+	suite.Len(grants, 2)
+
+	grants = nil
+	suite.keeper.IterateRoleGrants(
+		suite.ctx,
+		func(grant types.RoleGrant) bool {
+			grants = append(grants, grant)
+			return false
+		},
+	)
+	suite.Len(grants, 3)
+}
+
+func (suite *KeeperTestSuite) TestRevokeRole() {
+	suite.keeper.GrantRole(suite.ctx, types.NewRoleGrant(addrs[0], types.RoleOracleOperator, addrs[1]))
+	suite.keeper.GrantRole(suite.ctx, types.NewRoleGrant(addrs[0], types.RoleTokenAdmin, addrs[1]))
+
+	suite.keeper.RevokeRole(suite.ctx, addrs[0], types.RoleOracleOperator)
+	suite.False(suite.keeper.HasRole(suite.ctx, addrs[0], types.RoleOracleOperator))
+	suite.True(suite.keeper.HasRole(suite.ctx, addrs[0], types.RoleTokenAdmin))
+}
+
+func (suite *KeeperTestSuite) TestApproveAction() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[1], addrs[1]))
+	suite.keeper.SetParamSet(suite.ctx, types.NewParams(2, time.Hour))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	res, err := msgServer.AddSuper(ctx, types.NewMsgAddSuper("test", addrs[2], addrs[0]))
+	suite.NoError(err)
+	suite.Equal(uint64(1), res.ActionId)
+	_, found := suite.keeper.GetSuper(suite.ctx, addrs[2])
+	suite.False(found)
+
+	// the proposer has approved the action already
+	_, err = msgServer.ApproveAction(ctx, types.NewMsgApproveAction(res.ActionId, addrs[0]))
+	suite.ErrorIs(err, types.ErrActionApproved)
+	_, err = msgServer.ApproveAction(ctx, types.NewMsgApproveAction(res.ActionId, addrs[2]))
+	suite.ErrorIs(err, types.ErrUnknownOperator)
+
+	approveRes, err := msgServer.ApproveAction(ctx, types.NewMsgApproveAction(res.ActionId, addrs[1]))
+	suite.NoError(err)
+	suite.True(approveRes.Executed)
+
+	super, found := suite.keeper.GetSuper(suite.ctx, addrs[2])
+	suite.True(found)
+	suite.Equal(addrs[0].String(), super.AddedBy)
+	_, found = suite.keeper.GetPendingAction(suite.ctx, res.ActionId)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestApproveRoleActions() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[1], addrs[1]))
+	suite.keeper.SetParamSet(suite.ctx, types.NewParams(2, time.Hour))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	// a single genesis super can not grant a role unilaterally
+	res, err := msgServer.GrantRole(ctx, types.NewMsgGrantRole(addrs[2], types.RoleOracleOperator, addrs[0]))
+	suite.NoError(err)
+	suite.Equal(uint64(1), res.ActionId)
+	suite.False(suite.keeper.HasRole(suite.ctx, addrs[2], types.RoleOracleOperator))
+
+	approveRes, err := msgServer.ApproveAction(ctx, types.NewMsgApproveAction(res.ActionId, addrs[1]))
+	suite.NoError(err)
+	suite.True(approveRes.Executed)
+	grant, found := suite.keeper.GetRoleGrant(suite.ctx, addrs[2], types.RoleOracleOperator)
+	suite.True(found)
+	suite.Equal(addrs[0].String(), grant.GrantedBy)
+
+	_, err = msgServer.GrantRole(ctx, types.NewMsgGrantRole(addrs[2], types.RoleOracleOperator, addrs[1]))
+	suite.ErrorIs(err, types.ErrRoleExists)
+	_, err = msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(addrs[2], types.RoleOracleOperator, addrs[2]))
+	suite.ErrorIs(err, types.ErrUnknownOperator)
+
+	revokeRes, err := msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(addrs[2], types.RoleOracleOperator, addrs[1]))
+	suite.NoError(err)
+	suite.Equal(uint64(2), revokeRes.ActionId)
+	suite.True(suite.keeper.HasRole(suite.ctx, addrs[2], types.RoleOracleOperator))
+
+	// while the governance authority bypasses the approvals
+	revokeRes, err = msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(addrs[2], types.RoleOracleOperator, authority))
+	suite.NoError(err)
+	suite.Zero(revokeRes.ActionId)
+	suite.False(suite.keeper.HasRole(suite.ctx, addrs[2], types.RoleOracleOperator))
+
+	// the pending revocation can no longer be executed
+	_, err = msgServer.ApproveAction(ctx, types.NewMsgApproveAction(2, addrs[0]))
+	suite.ErrorIs(err, types.ErrUnknownRole)
+}
+
+func (suite *KeeperTestSuite) TestRoleAuthorizer() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.GrantRole(suite.ctx, types.NewRoleGrant(addrs[1], types.RoleOracleOperator, addrs[0]))
+
+	// the authorizer checks the role rather than the super status
+	authorizer := suite.keeper.NewRoleAuthorizer(types.RoleOracleOperator)
+	suite.False(authorizer.Authorized(suite.ctx, addrs[0]))
+	suite.True(authorizer.Authorized(suite.ctx, addrs[1]))
+	suite.False(suite.keeper.NewRoleAuthorizer(types.RoleTokenAdmin).Authorized(suite.ctx, addrs[1]))
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredActions() {
+	suite.keeper.SetParamSet(suite.ctx, types.NewParams(2, time.Hour))
+	action := suite.keeper.SubmitAction(
+		suite.ctx,
+		types.NewPendingAction(0, types.ActionDeleteSuper, addrs[2], "", addrs[0], suite.ctx.BlockTime()),
+	)
+	suite.Equal(uint64(1), action.Id)
+	suite.Equal(uint64(2), suite.keeper.GetNextActionID(suite.ctx))
+
+	suite.Empty(suite.keeper.PruneExpiredActions(suite.ctx.WithBlockTime(action.Deadline)))
+	expired := suite.keeper.PruneExpiredActions(suite.ctx.WithBlockTime(action.Deadline.Add(time.Second)))
+	suite.Len(expired, 1)
+	suite.Equal(action.Id, expired[0].Id)
+
+	_, found := suite.keeper.GetPendingAction(suite.ctx, action.Id)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestAuthority() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	suite.Equal(authority.String(), suite.keeper.GetAuthority())
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.SetParamSet(suite.ctx, types.NewParams(2, time.Hour))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	msg := types.NewMsgAddSuper("rotated", addrs[1], addrs[0])
+	msg.Genesis = true
+	_, err := msgServer.AddSuper(ctx, msg)
+	suite.ErrorIs(err, types.ErrInvalidAuthority)
+
+	// the authority adds a genesis super without approvals
+	msg.AddedBy = authority.String()
+	res, err := msgServer.AddSuper(ctx, msg)
+	suite.NoError(err)
+	suite.Zero(res.ActionId)
+	super, found := suite.keeper.GetSuper(suite.ctx, addrs[1])
+	suite.True(found)
+	suite.Equal(types.Genesis, super.AccountType)
+
+	_, err = msgServer.DeleteSuper(ctx, types.NewMsgDeleteSuper(addrs[0], addrs[1]))
+	suite.ErrorIs(err, types.ErrDeleteGenesisSuper)
+
+	// the authority deletes the old genesis super
+	_, err = msgServer.DeleteSuper(ctx, types.NewMsgDeleteSuper(addrs[0], authority))
+	suite.NoError(err)
+	_, found = suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestUpdateSuper() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	super := types.NewSuper("test", types.Ordinary, addrs[1], addrs[0])
+	super.ExpirationHeight = 100
+	suite.keeper.AddSuper(suite.ctx, super)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+
+	// even a genesis super can not update the others
+	_, err := msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper(addrs[1], "updated", false, addrs[0]))
+	suite.ErrorIs(err, types.ErrUnknownOperator)
+	_, err = msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper(addrs[1], "", true, addrs[1]))
+	suite.ErrorIs(err, types.ErrInvalidAuthority)
+	_, err = msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper(addrs[2], "updated", false, addrs[2]))
+	suite.ErrorIs(err, types.ErrUnknownSuper)
+
+	_, err = msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper(addrs[1], "updated", false, addrs[1]))
+	suite.NoError(err)
+	updated, _ := suite.keeper.GetSuper(suite.ctx, addrs[1])
+	suite.Equal("updated", updated.Description)
+	suite.Equal(types.Ordinary, updated.AccountType)
+	suite.Equal(addrs[0].String(), updated.AddedBy)
+	var last types.HistoryRecord
+	suite.keeper.IterateHistory(suite.ctx, func(record types.HistoryRecord) bool {
+		last = record
+		return false
+	})
+	suite.Equal(types.HistoryActionUpdateSuper, last.Action)
+	suite.Equal(addrs[1].String(), last.Actor)
+
+	// the promoted super keeps its description and no longer expires
+	_, err = msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper(addrs[1], "", true, authority))
+	suite.NoError(err)
+	promoted, _ := suite.keeper.GetSuper(suite.ctx, addrs[1])
+	suite.Equal("updated", promoted.Description)
+	suite.Equal(types.Genesis, promoted.AccountType)
+	suite.False(promoted.HasExpiration())
+	suite.Empty(suite.keeper.PruneExpiredSupers(suite.ctx.WithBlockHeight(100)))
+}
+
+func (suite *KeeperTestSuite) TestGenesisSuperInvariant() {
+	invariant := keeper.GenesisSuperInvariant(suite.keeper)
+	_, broken := invariant(suite.ctx)
+	suite.False(broken)
+	suite.Len(suite.genesisSupers, 1)
+
+	// the last genesis super can not be deleted
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	genesisSuper, _ := sdk.AccAddressFromBech32(suite.genesisSupers[0].Address)
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	_, err := msgServer.DeleteSuper(sdk.WrapSDKContext(suite.ctx), types.NewMsgDeleteSuper(genesisSuper, authority))
+	suite.ErrorIs(err, types.ErrDeleteGenesisSuper)
+
+	suite.keeper.DeleteSuper(suite.ctx, genesisSuper)
+	_, broken = invariant(suite.ctx)
+	suite.True(broken)
+}
+
 func newPubKey(pk string) (res cryptotypes.PubKey) {
 	pkBytes, err := hex.DecodeString(pk)
 	if err != nil {
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2 by initializing the guardian params.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetParamSet(ctx, types.DefaultParams())
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3 by indexing the existing supers by
+// the address which added them.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var supers []types.Super
+	m.keeper.IterateSupers(ctx, func(super types.Super) bool {
+		supers = append(supers, super)
+		return false
+	})
+
+	for _, super := range supers {
+		address, err := sdk.AccAddressFromBech32(super.Address)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid address of super %s", super.Address)
+		}
+		if _, err := sdk.AccAddressFromBech32(super.AddedBy); err != nil {
+			return sdkerrors.Wrapf(err, "invalid added_by %s of super %s", super.AddedBy, super.Address)
+		}
+		m.keeper.setAddedByIndex(ctx, super, address)
+	}
+	return nil
+}
@@ -31,27 +31,49 @@ func (m msgServer) AddSuper(goCtx context.Context, msg *types.MsgAddSuper) (*typ
 	if err != nil {
 		return nil, err
 	}
-	if super, found := m.Keeper.GetSuper(ctx, addedBy); !found || super.GetAccountType() != types.Genesis {
-		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.AddedBy)
+	// the governance authority bypasses the approval of the genesis supers
+	isAuthority := msg.AddedBy == m.Keeper.GetAuthority()
+	if !isAuthority {
+		if super, found := m.Keeper.GetSuper(ctx, addedBy); !found || super.GetAccountType() != types.Genesis {
+			return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.AddedBy)
+		}
+		if msg.Genesis {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "only %s can add genesis supers", m.Keeper.GetAuthority())
+		}
 	}
-	if _, found := m.Keeper.GetSuper(ctx, address); found {
-		return nil, sdkerrors.Wrap(types.ErrSuperExists, msg.Address)
+
+	action := types.NewPendingAction(0, types.ActionAddSuper, address, msg.Description, addedBy, ctx.BlockTime())
+	action.ExpirationHeight = msg.ExpirationHeight
+	action.ExpirationTime = msg.ExpirationTime
+	if err := m.Keeper.ValidateAction(ctx, action); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.AddedBy)
+
+	if !isAuthority && m.Keeper.GetParamSet(ctx).ApprovalThreshold > 1 {
+		action = m.Keeper.SubmitAction(ctx, action)
+		emitSubmitActionEvent(ctx, action)
+		return &types.MsgAddSuperResponse{ActionId: action.Id}, nil
+	}
+
+	accountType := types.Ordinary
+	if msg.Genesis {
+		accountType = types.Genesis
 	}
-	super := types.NewSuper(msg.Description, types.Ordinary, address, addedBy)
+	super := types.NewSuper(msg.Description, accountType, address, addedBy)
+	super.ExpirationHeight = msg.ExpirationHeight
+	super.ExpirationTime = msg.ExpirationTime
 	m.Keeper.AddSuper(ctx, super)
+	m.Keeper.RecordHistory(ctx, types.HistoryActionAddSuper, msg.AddedBy, msg.Address, msg.Description)
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.AddedBy),
-		),
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeAddSuper,
 			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
 			sdk.NewAttribute(types.AttributeKeyAddedBy, msg.AddedBy),
 		),
-	})
+	)
 
 	return &types.MsgAddSuperResponse{}, nil
 }
@@ -67,32 +89,264 @@ func (m msgServer) DeleteSuper(goCtx context.Context, msg *types.MsgDeleteSuper)
 	if err != nil {
 		return nil, err
 	}
+	if msg.DeletedBy == m.Keeper.GetAuthority() {
+		// the governance authority is able to delete genesis supers as well
+		super, found := m.Keeper.GetSuper(ctx, address)
+		if !found {
+			return nil, sdkerrors.Wrap(types.ErrUnknownSuper, msg.Address)
+		}
+		if super.GetAccountType() == types.Genesis && m.Keeper.CountGenesisSupers(ctx) <= 1 {
+			return nil, sdkerrors.Wrapf(types.ErrDeleteGenesisSuper, "%s is the last genesis super", msg.Address)
+		}
+	} else {
+		if super, found := m.Keeper.GetSuper(ctx, deletedBy); !found || super.GetAccountType() != types.Genesis {
+			return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.DeletedBy)
+		}
+
+		action := types.NewPendingAction(0, types.ActionDeleteSuper, address, "", deletedBy, ctx.BlockTime())
+		if err := m.Keeper.ValidateAction(ctx, action); err != nil {
+			return nil, err
+		}
+
+		if m.Keeper.GetParamSet(ctx).ApprovalThreshold > 1 {
+			action = m.Keeper.SubmitAction(ctx, action)
+			emitMessageEvent(ctx, msg.DeletedBy)
+			emitSubmitActionEvent(ctx, action)
+			return &types.MsgDeleteSuperResponse{ActionId: action.Id}, nil
+		}
+	}
 
-	if super, found := m.Keeper.GetSuper(ctx, deletedBy); !found || super.GetAccountType() != types.Genesis {
-		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.DeletedBy)
+	emitMessageEvent(ctx, msg.DeletedBy)
+	m.Keeper.DeleteSuper(ctx, address)
+	m.Keeper.RecordHistory(ctx, types.HistoryActionDeleteSuper, msg.DeletedBy, msg.Address, "")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteSuper,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyDeletedBy, msg.DeletedBy),
+		),
+	)
+
+	return &types.MsgDeleteSuperResponse{}, nil
+}
+
+func (m msgServer) UpdateSuper(goCtx context.Context, msg *types.MsgUpdateSuper) (*types.MsgUpdateSuperResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	updatedBy, err := sdk.AccAddressFromBech32(msg.UpdatedBy)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	// a super can only update its own description, while the governance authority can update any super
+	if msg.UpdatedBy != m.Keeper.GetAuthority() {
+		if !updatedBy.Equals(address) {
+			return nil, sdkerrors.Wrapf(types.ErrUnknownOperator, "%s can not update %s", msg.UpdatedBy, msg.Address)
+		}
+		if msg.Genesis {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "only %s can promote supers", m.Keeper.GetAuthority())
+		}
 	}
 	super, found := m.Keeper.GetSuper(ctx, address)
 	if !found {
 		return nil, sdkerrors.Wrap(types.ErrUnknownSuper, msg.Address)
 	}
-	if super.GetAccountType() == types.Genesis {
-		return nil, sdkerrors.Wrap(types.ErrDeleteGenesisSuper, msg.Address)
+
+	if len(msg.Description) > 0 {
+		super.Description = msg.Description
+	}
+	if msg.Genesis {
+		if super.AccountType == types.Genesis {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is a genesis super already", msg.Address)
+		}
+		// genesis supers can not expire
+		super.AccountType = types.Genesis
+		super.ExpirationHeight = 0
+		super.ExpirationTime = nil
 	}
+	m.Keeper.AddSuper(ctx, super)
+	m.Keeper.RecordHistory(ctx, types.HistoryActionUpdateSuper, msg.UpdatedBy, msg.Address, super.Description)
 
-	m.Keeper.DeleteSuper(ctx, address)
+	emitMessageEvent(ctx, msg.UpdatedBy)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateSuper,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyAccountType, super.AccountType.String()),
+			sdk.NewAttribute(types.AttributeKeyUpdatedBy, msg.UpdatedBy),
+		),
+	)
+
+	return &types.MsgUpdateSuperResponse{}, nil
+}
+
+func (m msgServer) ApproveAction(goCtx context.Context, msg *types.MsgApproveAction) (*types.MsgApproveActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	approver, err := sdk.AccAddressFromBech32(msg.Approver)
+	if err != nil {
+		return nil, err
+	}
+	if super, found := m.Keeper.GetSuper(ctx, approver); !found || super.GetAccountType() != types.Genesis {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Approver)
+	}
+	action, found := m.Keeper.GetPendingAction(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownAction, "%d", msg.Id)
+	}
+	if ctx.BlockTime().After(action.Deadline) {
+		return nil, sdkerrors.Wrapf(types.ErrActionExpired, "%d", msg.Id)
+	}
+	if action.HasApproved(approver) {
+		return nil, sdkerrors.Wrapf(types.ErrActionApproved, "%d: %s", msg.Id, msg.Approver)
+	}
+	action.Approvals = append(action.Approvals, msg.Approver)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeletedBy),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Approver),
 		),
 		sdk.NewEvent(
-			types.EventTypeDeleteSuper,
-			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
-			sdk.NewAttribute(types.AttributeKeyDeletedBy, msg.DeletedBy),
+			types.EventTypeApproveAction,
+			sdk.NewAttribute(types.AttributeKeyActionID, sdk.NewUint(action.Id).String()),
+			sdk.NewAttribute(types.AttributeKeyApprover, msg.Approver),
+			sdk.NewAttribute(types.AttributeKeyApprovals, sdk.NewInt(int64(len(action.Approvals))).String()),
 		),
 	})
 
-	return &types.MsgDeleteSuperResponse{}, nil
+	if uint32(len(action.Approvals)) < m.Keeper.GetParamSet(ctx).ApprovalThreshold {
+		m.Keeper.SetPendingAction(ctx, action)
+		return &types.MsgApproveActionResponse{}, nil
+	}
+
+	m.Keeper.DeletePendingAction(ctx, action)
+	if err := m.Keeper.ExecuteAction(ctx, action); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveActionResponse{Executed: true}, nil
+}
+
+func (m msgServer) GrantRole(goCtx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantedBy, err := sdk.AccAddressFromBech32(msg.GrantedBy)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	// the governance authority bypasses the approval of the genesis supers
+	isAuthority := msg.GrantedBy == m.Keeper.GetAuthority()
+	if !isAuthority {
+		if super, found := m.Keeper.GetSuper(ctx, grantedBy); !found || super.GetAccountType() != types.Genesis {
+			return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.GrantedBy)
+		}
+	}
+
+	action := types.NewPendingAction(0, types.ActionGrantRole, address, "", grantedBy, ctx.BlockTime())
+	action.Role = msg.Role
+	if err := m.Keeper.ValidateAction(ctx, action); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.GrantedBy)
+
+	if !isAuthority && m.Keeper.GetParamSet(ctx).ApprovalThreshold > 1 {
+		action = m.Keeper.SubmitAction(ctx, action)
+		emitSubmitActionEvent(ctx, action)
+		return &types.MsgGrantRoleResponse{ActionId: action.Id}, nil
+	}
+
+	m.Keeper.GrantRole(ctx, types.NewRoleGrant(address, msg.Role, grantedBy))
+	m.Keeper.RecordHistory(ctx, types.HistoryActionGrantRole, msg.GrantedBy, msg.Address, msg.Role)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantRole,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
+			sdk.NewAttribute(types.AttributeKeyGrantedBy, msg.GrantedBy),
+		),
+	)
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (m msgServer) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	revokedBy, err := sdk.AccAddressFromBech32(msg.RevokedBy)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	// the governance authority bypasses the approval of the genesis supers
+	isAuthority := msg.RevokedBy == m.Keeper.GetAuthority()
+	if !isAuthority {
+		if super, found := m.Keeper.GetSuper(ctx, revokedBy); !found || super.GetAccountType() != types.Genesis {
+			return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.RevokedBy)
+		}
+	}
+
+	action := types.NewPendingAction(0, types.ActionRevokeRole, address, "", revokedBy, ctx.BlockTime())
+	action.Role = msg.Role
+	if err := m.Keeper.ValidateAction(ctx, action); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.RevokedBy)
+
+	if !isAuthority && m.Keeper.GetParamSet(ctx).ApprovalThreshold > 1 {
+		action = m.Keeper.SubmitAction(ctx, action)
+		emitSubmitActionEvent(ctx, action)
+		return &types.MsgRevokeRoleResponse{ActionId: action.Id}, nil
+	}
+
+	m.Keeper.RevokeRole(ctx, address, msg.Role)
+	m.Keeper.RecordHistory(ctx, types.HistoryActionRevokeRole, msg.RevokedBy, msg.Address, msg.Role)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeRole,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
+			sdk.NewAttribute(types.AttributeKeyRevokedBy, msg.RevokedBy),
+		),
+	)
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+func emitMessageEvent(ctx sdk.Context, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		),
+	)
+}
+
+func emitSubmitActionEvent(ctx sdk.Context, action types.PendingAction) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitAction,
+			sdk.NewAttribute(types.AttributeKeyActionID, sdk.NewUint(action.Id).String()),
+			sdk.NewAttribute(types.AttributeKeyActionType, action.ActionType.String()),
+			sdk.NewAttribute(types.AttributeKeySuperAddress, action.Address),
+			sdk.NewAttribute(types.AttributeKeyProposer, action.Proposer),
+		),
+	)
 }
@@ -32,8 +32,9 @@ func (suite *KeeperTestSuite) TestNewQuerier() {
 	e := suite.cdc.UnmarshalJSON(res, &supers)
 	suite.NoError(e)
 
+	suite.Len(supers, len(suite.genesisSupers))
 	for i, val := range supers {
-		equal := val.Equal(types.DefaultGenesisState().Supers[i])
+		equal := val.Equal(suite.genesisSupers[i])
 		suite.True(equal)
 	}
 }
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// GrantRole stores the role grant and its index by role
+func (k Keeper) GrantRole(ctx sdk.Context, grant types.RoleGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&grant)
+	address, _ := sdk.AccAddressFromBech32(grant.Address)
+	store.Set(types.GetRoleKey(address, grant.Role), bz)
+	store.Set(types.GetRoleAccountKey(grant.Role, address), address.Bytes())
+}
+
+// RevokeRole deletes the role granted to the specified address
+func (k Keeper) RevokeRole(ctx sdk.Context, address sdk.AccAddress, role string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRoleKey(address, role))
+	store.Delete(types.GetRoleAccountKey(role, address))
+}
+
+// GetRoleGrant retrieves the role grant by the specified address and role
+func (k Keeper) GetRoleGrant(ctx sdk.Context, address sdk.AccAddress, role string) (grant types.RoleGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetRoleKey(address, role)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &grant)
+		return grant, true
+	}
+	return grant, false
+}
+
+// HasRole returns true if the role has been granted to the specified address
+func (k Keeper) HasRole(ctx sdk.Context, address sdk.AccAddress, role string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetRoleKey(address, role))
+}
+
+// RoleAuthorizer authorizes the accounts which have been granted a specific role
+type RoleAuthorizer struct {
+	keeper Keeper
+	role   string
+}
+
+// NewRoleAuthorizer returns a RoleAuthorizer of the role, which can be used in place of the
+// keeper by the consumers checking the permissions of accounts with Authorized
+func (k Keeper) NewRoleAuthorizer(role string) RoleAuthorizer {
+	return RoleAuthorizer{keeper: k, role: role}
+}
+
+// Authorized returns true if the role has been granted to the specified address
+func (a RoleAuthorizer) Authorized(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return a.keeper.HasRole(ctx, addr, a.role)
+}
+
+// IterateRoleGrants iterates through all role grants
+func (k Keeper) IterateRoleGrants(
+	ctx sdk.Context,
+	op func(grant types.RoleGrant) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.RoleKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.RoleGrant
+		k.cdc.MustUnmarshal(iterator.Value(), &grant)
+
+		if stop := op(grant); stop {
+			break
+		}
+	}
+}
+
+// IterateAccountRoles iterates through all roles granted to the specified address
+func (k Keeper) IterateAccountRoles(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+	op func(grant types.RoleGrant) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAccountRolesSubspaceKey(address))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.RoleGrant
+		k.cdc.MustUnmarshal(iterator.Value(), &grant)
+
+		if stop := op(grant); stop {
+			break
+		}
+	}
+}
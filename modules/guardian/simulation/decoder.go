@@ -0,0 +1,53 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// NewDecodeStore returns a function closure that unmarshals the KVPair's values
+// to the corresponding types.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.SuperKey):
+			var superA, superB types.Super
+			cdc.MustUnmarshal(kvA.Value, &superA)
+			cdc.MustUnmarshal(kvB.Value, &superB)
+			return fmt.Sprintf("%v\n%v", superA, superB)
+		case bytes.Equal(kvA.Key[:1], types.RoleKey):
+			var grantA, grantB types.RoleGrant
+			cdc.MustUnmarshal(kvA.Value, &grantA)
+			cdc.MustUnmarshal(kvB.Value, &grantB)
+			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], types.PendingActionKey):
+			var actionA, actionB types.PendingAction
+			cdc.MustUnmarshal(kvA.Value, &actionA)
+			cdc.MustUnmarshal(kvB.Value, &actionB)
+			return fmt.Sprintf("%v\n%v", actionA, actionB)
+		case bytes.Equal(kvA.Key[:1], types.HistoryKey):
+			var recordA, recordB types.HistoryRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.NextActionIDKey),
+			bytes.Equal(kvA.Key[:1], types.NextHistoryIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.RoleAccountKey),
+			bytes.Equal(kvA.Key[:1], types.SuperExpirationTimeKey),
+			bytes.Equal(kvA.Key[:1], types.SuperExpirationHeightKey),
+			bytes.Equal(kvA.Key[:1], types.PendingActionQueueKey),
+			bytes.Equal(kvA.Key[:1], types.SuperAddedByKey):
+			// the indexes store the addresses or the ids of the indexed entries
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		default:
+			panic(fmt.Sprintf("invalid guardian key %X", kvA.Key))
+		}
+	}
+}
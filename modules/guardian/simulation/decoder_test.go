@@ -0,0 +1,55 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/irisnet/irishub/modules/guardian/simulation"
+	"github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+func TestDecodeStore(t *testing.T) {
+	addr := sdk.AccAddress(tmcrypto.AddressHash([]byte("super")))
+	super := types.NewSuper("test", types.Genesis, addr, addr)
+	record := types.HistoryRecord{Id: 1, Action: types.HistoryActionAddSuper, Actor: addr.String(), Target: addr.String()}
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetSuperKey(addr), Value: cdc.MustMarshal(&super)},
+			{Key: types.GetHistoryKey(record.Id), Value: cdc.MustMarshal(&record)},
+			{Key: types.NextHistoryIDKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Super", fmt.Sprintf("%v\n%v", super, super)},
+		{"HistoryRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"NextHistoryID", "2\n2"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// Simulation parameter constants
+const (
+	GenesisSupers  = "genesis_supers"
+	OrdinarySupers = "ordinary_supers"
+)
+
+// RandomizedGenState generates a random GenesisState for guardian
+func RandomizedGenState(simState *module.SimulationState) {
+	var genesisSupers, ordinarySupers int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GenesisSupers, &genesisSupers, simState.Rand,
+		func(r *rand.Rand) { genesisSupers = simtypes.RandIntBetween(r, 1, 4) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OrdinarySupers, &ordinarySupers, simState.Rand,
+		func(r *rand.Rand) { ordinarySupers = simtypes.RandIntBetween(r, 0, 6) },
+	)
+
+	accs := make([]simtypes.Account, len(simState.Accounts))
+	copy(accs, simState.Accounts)
+	simState.Rand.Shuffle(len(accs), func(i, j int) { accs[i], accs[j] = accs[j], accs[i] })
+
+	if genesisSupers > len(accs) {
+		genesisSupers = len(accs)
+	}
+	if genesisSupers+ordinarySupers > len(accs) {
+		ordinarySupers = len(accs) - genesisSupers
+	}
+
+	var supers []types.Super
+	for _, acc := range accs[:genesisSupers] {
+		supers = append(supers, types.NewSuper("genesis", types.Genesis, acc.Address, accs[0].Address))
+	}
+	for _, acc := range accs[genesisSupers : genesisSupers+ordinarySupers] {
+		addedBy := accs[simState.Rand.Intn(genesisSupers)].Address
+		supers = append(supers, types.NewSuper(simtypes.RandStringOfLength(simState.Rand, 10), types.Ordinary, acc.Address, addedBy))
+	}
+
+	guardianGenesis := types.NewGenesisState(supers, nil, types.DefaultParams(), nil, nil, 1)
+
+	bz, err := json.MarshalIndent(&guardianGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(guardianGenesis)
+}
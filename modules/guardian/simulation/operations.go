@@ -0,0 +1,160 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgAddSuper    = "op_weight_msg_add_super"
+	OpWeightMsgDeleteSuper = "op_weight_msg_delete_super"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var weightAdd, weightDelete int
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgAddSuper, &weightAdd, nil,
+		func(_ *rand.Rand) {
+			weightAdd = 20
+		},
+	)
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgDeleteSuper, &weightDelete, nil,
+		func(_ *rand.Rand) {
+			weightDelete = 10
+		},
+	)
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightAdd,
+			SimulateMsgAddSuper(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightDelete,
+			SimulateMsgDeleteSuper(k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgAddSuper generates a MsgAddSuper with random values
+func SimulateMsgAddSuper(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (
+		simtypes.OperationMsg, []simtypes.FutureOperation, error,
+	) {
+		operator, found := randomGenesisSuper(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSuper, "no genesis super found"), nil, nil
+		}
+
+		account, _ := simtypes.RandomAcc(r, accs)
+		if _, found := k.GetSuper(ctx, account.Address); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSuper, "super already exists"), nil, nil
+		}
+
+		msg := types.NewMsgAddSuper(simtypes.RandStringOfLength(r, 10), account.Address, operator.Address)
+		if r.Intn(2) == 0 {
+			msg.ExpirationHeight = ctx.BlockHeight() + int64(simtypes.RandIntBetween(r, 1, 100))
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      operator,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgDeleteSuper generates a MsgDeleteSuper with random values
+func SimulateMsgDeleteSuper(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (
+		simtypes.OperationMsg, []simtypes.FutureOperation, error,
+	) {
+		operator, found := randomGenesisSuper(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteSuper, "no genesis super found"), nil, nil
+		}
+
+		// only ordinary supers can be deleted by a genesis super
+		var ordinarySupers []types.Super
+		k.IterateSupers(ctx, func(super types.Super) bool {
+			if super.AccountType == types.Ordinary {
+				ordinarySupers = append(ordinarySupers, super)
+			}
+			return false
+		})
+		if len(ordinarySupers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteSuper, "no ordinary super found"), nil, nil
+		}
+		address, _ := sdk.AccAddressFromBech32(ordinarySupers[r.Intn(len(ordinarySupers))].Address)
+
+		msg := types.NewMsgDeleteSuper(address, operator.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      operator,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomGenesisSuper returns a random simulation account which is a genesis super
+func randomGenesisSuper(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	var operators []simtypes.Account
+	k.IterateSupers(ctx, func(super types.Super) bool {
+		if super.AccountType != types.Genesis {
+			return false
+		}
+		address, _ := sdk.AccAddressFromBech32(super.Address)
+		if acc, found := simtypes.FindAccount(accs, address); found {
+			operators = append(operators, acc)
+		}
+		return false
+	})
+	if len(operators) == 0 {
+		return simtypes.Account{}, false
+	}
+	return operators[r.Intn(len(operators))], true
+}
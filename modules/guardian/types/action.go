@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPendingAction constructs a pending action proposed and approved by the proposer
+func NewPendingAction(
+	id uint64,
+	actionType ActionType,
+	address sdk.AccAddress,
+	description string,
+	proposer sdk.AccAddress,
+	deadline time.Time,
+) PendingAction {
+	return PendingAction{
+		Id:          id,
+		ActionType:  actionType,
+		Address:     address.String(),
+		Description: description,
+		Proposer:    proposer.String(),
+		Approvals:   []string{proposer.String()},
+		Deadline:    deadline,
+	}
+}
+
+// HasApproved returns true if the given address has approved the action
+func (a PendingAction) HasApproved(approver sdk.AccAddress) bool {
+	for _, approval := range a.Approvals {
+		if approval == approver.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidActionType returns true if the ActionType option is valid and false otherwise.
+func ValidActionType(option ActionType) bool {
+	return option == ActionAddSuper ||
+		option == ActionDeleteSuper ||
+		option == ActionGrantRole ||
+		option == ActionRevokeRole
+}
+
+// Format implements the fmt.Formatter interface.
+func (at ActionType) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(at.String()))
+	default:
+		s.Write([]byte(fmt.Sprintf("%v", int32(at))))
+	}
+}
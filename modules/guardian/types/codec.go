@@ -13,12 +13,20 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddSuper{}, "irishub/guardian/MsgAddSuper", nil)
 	cdc.RegisterConcrete(&MsgDeleteSuper{}, "irishub/guardian/MsgDeleteSuper", nil)
+	cdc.RegisterConcrete(&MsgUpdateSuper{}, "irishub/guardian/MsgUpdateSuper", nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, "irishub/guardian/MsgGrantRole", nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, "irishub/guardian/MsgRevokeRole", nil)
+	cdc.RegisterConcrete(&MsgApproveAction{}, "irishub/guardian/MsgApproveAction", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddSuper{},
 		&MsgDeleteSuper{},
+		&MsgUpdateSuper{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgApproveAction{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
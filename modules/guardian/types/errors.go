@@ -10,4 +10,13 @@ var (
 	ErrUnknownSuper       = sdkerrors.Register(ModuleName, 3, "unknown super")
 	ErrSuperExists        = sdkerrors.Register(ModuleName, 4, "super already exists")
 	ErrDeleteGenesisSuper = sdkerrors.Register(ModuleName, 5, "can't delete genesis super")
+	ErrInvalidRole        = sdkerrors.Register(ModuleName, 6, "invalid role")
+	ErrRoleExists         = sdkerrors.Register(ModuleName, 7, "role already granted")
+	ErrUnknownRole        = sdkerrors.Register(ModuleName, 8, "role not granted")
+	ErrInvalidExpiration  = sdkerrors.Register(ModuleName, 9, "invalid expiration")
+	ErrInvalidParams      = sdkerrors.Register(ModuleName, 10, "invalid params")
+	ErrUnknownAction      = sdkerrors.Register(ModuleName, 11, "unknown pending action")
+	ErrActionApproved     = sdkerrors.Register(ModuleName, 12, "action already approved")
+	ErrActionExpired      = sdkerrors.Register(ModuleName, 13, "action expired")
+	ErrInvalidAuthority   = sdkerrors.Register(ModuleName, 14, "invalid authority")
 )
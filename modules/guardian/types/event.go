@@ -5,10 +5,30 @@ package types
 const (
 	EventTypeAddSuper    = "add_super"
 	EventTypeDeleteSuper = "delete_super"
+	EventTypeUpdateSuper = "update_super"
+	EventTypeGrantRole   = "grant_role"
+	EventTypeRevokeRole  = "revoke_role"
+
+	EventTypeSubmitAction  = "submit_action"
+	EventTypeApproveAction = "approve_action"
+	EventTypeExpireAction  = "expire_action"
 
 	AttributeKeySuperAddress = "address"
 	AttributeKeyAddedBy      = "added_by"
 	AttributeKeyDeletedBy    = "deleted_by"
+	AttributeKeyUpdatedBy    = "updated_by"
+	AttributeKeyAccountType  = "account_type"
+	AttributeKeyRole         = "role"
+	AttributeKeyGrantedBy    = "granted_by"
+	AttributeKeyRevokedBy    = "revoked_by"
+	AttributeKeyReason       = "reason"
+	AttributeKeyActionID     = "action_id"
+	AttributeKeyActionType   = "action_type"
+	AttributeKeyProposer     = "proposer"
+	AttributeKeyApprover     = "approver"
+	AttributeKeyApprovals    = "approvals"
+
+	AttributeValueExpired = "expired"
 
 	AttributeValueCategory = ModuleName
 )
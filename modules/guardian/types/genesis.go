@@ -1,13 +1,28 @@
 package types
 
 // NewGenesisState constructs a GenesisState
-func NewGenesisState(supers []Super) *GenesisState {
+func NewGenesisState(
+	supers []Super,
+	roleGrants []RoleGrant,
+	params Params,
+	pendingActions []PendingAction,
+	history []HistoryRecord,
+	nextActionID uint64,
+) *GenesisState {
 	return &GenesisState{
-		Supers: supers,
+		Supers:         supers,
+		RoleGrants:     roleGrants,
+		Params:         params,
+		PendingActions: pendingActions,
+		History:        history,
+		NextActionId:   nextActionID,
 	}
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params:       DefaultParams(),
+		NextActionId: 1,
+	}
 }
@@ -25,7 +25,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the guardian module's genesis state
 type GenesisState struct {
-	Supers []Super `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
+	Supers         []Super         `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
+	RoleGrants     []RoleGrant     `protobuf:"bytes,2,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants"`
+	Params         Params          `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	PendingActions []PendingAction `protobuf:"bytes,4,rep,name=pending_actions,json=pendingActions,proto3" json:"pending_actions"`
+	History        []HistoryRecord `protobuf:"bytes,5,rep,name=history,proto3" json:"history"`
+	// id of the next pending action, greater than the ids of all the actions ever submitted
+	NextActionId uint64 `protobuf:"varint,6,opt,name=next_action_id,json=nextActionId,proto3" json:"next_action_id,omitempty" yaml:"next_action_id"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +74,41 @@ func (m *GenesisState) GetSupers() []Super {
 	return nil
 }
 
+func (m *GenesisState) GetRoleGrants() []RoleGrant {
+	if m != nil {
+		return m.RoleGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPendingActions() []PendingAction {
+	if m != nil {
+		return m.PendingActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHistory() []HistoryRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextActionId() uint64 {
+	if m != nil {
+		return m.NextActionId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "irishub.guardian.GenesisState")
 }
@@ -75,20 +116,30 @@ func init() {
 func init() { proto.RegisterFile("guardian/genesis.proto", fileDescriptor_5203106ad1456439) }
 
 var fileDescriptor_5203106ad1456439 = []byte{
-	// 195 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0xc8, 0x2c, 0xca, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x83, 0xc9, 0x4b, 0x89,
-	0x23, 0x54, 0x42, 0x19, 0x10, 0xa5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0x72, 0xe5, 0xe2, 0x71, 0x87, 0x98, 0x18, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64,
-	0xca, 0xc5, 0x56, 0x5c, 0x5a, 0x90, 0x5a, 0x54, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24,
-	0xae, 0x87, 0x6e, 0x83, 0x5e, 0x30, 0x48, 0xde, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8,
-	0x62, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf,
-	0x2c, 0x01, 0x69, 0x4f, 0xce, 0xcf, 0xd5, 0x07, 0x19, 0x95, 0x97, 0x5a, 0xa2, 0x0f, 0x35, 0x52,
-	0x3f, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0xb5, 0x18, 0xee, 0x52, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0xd3, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x50, 0x6e, 0x87, 0x2a, 0xf5,
-	0x00, 0x00, 0x00,
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x85, 0x13, 0x5b, 0x23, 0x4c, 0x4b, 0x95, 0xa0, 0x36, 0x56, 0x48, 0x4b, 0x57, 0x5d, 0x25,
+	0x58, 0xd1, 0x85, 0x9b, 0x62, 0x36, 0x55, 0x04, 0x91, 0x74, 0xe7, 0xa6, 0x4c, 0x9b, 0x61, 0x3a,
+	0x90, 0xcc, 0x84, 0x99, 0x09, 0xd8, 0xb7, 0x10, 0x7c, 0xa9, 0x2e, 0xbb, 0x74, 0x55, 0xa4, 0x7d,
+	0x03, 0x9f, 0x40, 0x92, 0x99, 0xf8, 0x57, 0xdd, 0x5d, 0xee, 0xf9, 0xce, 0xb9, 0x07, 0x2e, 0x38,
+	0xc6, 0x19, 0xe4, 0x11, 0x81, 0xd4, 0xc7, 0x88, 0x22, 0x41, 0x84, 0x97, 0x72, 0x26, 0x99, 0x7d,
+	0x40, 0x38, 0x11, 0xb3, 0x6c, 0xe2, 0x95, 0x7a, 0xab, 0xf9, 0x45, 0xea, 0x41, 0xa1, 0xad, 0x43,
+	0xcc, 0x30, 0x2b, 0x46, 0x3f, 0x9f, 0xd4, 0xb6, 0xfb, 0x52, 0x01, 0xf5, 0xa1, 0x8a, 0x1c, 0x49,
+	0x28, 0x91, 0x7d, 0x01, 0x2c, 0x91, 0xa5, 0x88, 0x0b, 0xc7, 0xec, 0x54, 0x7a, 0xb5, 0x7e, 0xd3,
+	0xfb, 0x7d, 0xc2, 0x1b, 0xe5, 0x7a, 0x50, 0x5d, 0xac, 0xda, 0x46, 0xa8, 0x61, 0x3b, 0x00, 0x35,
+	0xce, 0x62, 0x34, 0xc6, 0x1c, 0x52, 0x29, 0x9c, 0x9d, 0xc2, 0x7b, 0xba, 0xed, 0x0d, 0x59, 0x8c,
+	0x86, 0x39, 0xa3, 0xfd, 0x80, 0x97, 0x0b, 0x61, 0x5f, 0x02, 0x2b, 0x85, 0x1c, 0x26, 0xc2, 0xa9,
+	0x74, 0xcc, 0x5e, 0xad, 0xef, 0x6c, 0xdb, 0x1f, 0x0a, 0xbd, 0xbc, 0xad, 0x68, 0xfb, 0x1e, 0xec,
+	0xa7, 0x88, 0x46, 0x84, 0xe2, 0x31, 0x9c, 0x4a, 0xc2, 0xa8, 0x70, 0xaa, 0xc5, 0xfd, 0xf6, 0x1f,
+	0x01, 0x0a, 0xbc, 0x2e, 0x38, 0x9d, 0xd3, 0x48, 0xbf, 0x2f, 0x85, 0x3d, 0x00, 0x7b, 0x33, 0x22,
+	0x24, 0xe3, 0x73, 0x67, 0xf7, 0xbf, 0x9c, 0x1b, 0x05, 0x84, 0x68, 0xca, 0x78, 0xa4, 0x73, 0x4a,
+	0x97, 0x3d, 0x00, 0x0d, 0x8a, 0x9e, 0xa4, 0x6e, 0x33, 0x26, 0x91, 0x63, 0x75, 0xcc, 0x5e, 0x35,
+	0x38, 0x79, 0x5f, 0xb5, 0x8f, 0xe6, 0x30, 0x89, 0xaf, 0xba, 0x3f, 0xf5, 0x6e, 0x58, 0xcf, 0x17,
+	0xaa, 0xc0, 0x6d, 0x14, 0xdc, 0x2d, 0xd6, 0xae, 0xb9, 0x5c, 0xbb, 0xe6, 0xdb, 0xda, 0x35, 0x9f,
+	0x37, 0xae, 0xb1, 0xdc, 0xb8, 0xc6, 0xeb, 0xc6, 0x35, 0x1e, 0xcf, 0x30, 0x91, 0x79, 0x91, 0x29,
+	0x4b, 0xfc, 0xbc, 0x14, 0x45, 0xd2, 0xd7, 0xe5, 0xfc, 0x84, 0x45, 0x59, 0x8c, 0xc4, 0xe7, 0xe3,
+	0x7d, 0x39, 0x4f, 0x91, 0x98, 0x58, 0xc5, 0xa7, 0xcf, 0x3f, 0x06, 0x00, 0xdf, 0x5d, 0xde, 0x45,
+	0x44, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +162,63 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextActionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextActionId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingActions) > 0 {
+		for iNdEx := len(m.PendingActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RoleGrants) > 0 {
+		for iNdEx := len(m.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Supers) > 0 {
 		for iNdEx := len(m.Supers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -151,6 +259,29 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RoleGrants) > 0 {
+		for _, e := range m.RoleGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingActions) > 0 {
+		for _, e := range m.PendingActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextActionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextActionId))
+	}
 	return n
 }
 
@@ -223,6 +354,160 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleGrants = append(m.RoleGrants, RoleGrant{})
+			if err := m.RoleGrants[len(m.RoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingActions = append(m.PendingActions, PendingAction{})
+			if err := m.PendingActions[len(m.PendingActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, HistoryRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextActionId", wireType)
+			}
+			m.NextActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,25 @@
+package types
+
+// Actions of the history records
+const (
+	HistoryActionAddSuper    = "add_super"
+	HistoryActionDeleteSuper = "delete_super"
+	HistoryActionUpdateSuper = "update_super"
+	HistoryActionExpireSuper = "expire_super"
+	HistoryActionGrantRole   = "grant_role"
+	HistoryActionRevokeRole  = "revoke_role"
+)
+
+// ValidHistoryAction returns true if the action of a history record is valid and false otherwise.
+func ValidHistoryAction(action string) bool {
+	switch action {
+	case HistoryActionAddSuper,
+		HistoryActionDeleteSuper,
+		HistoryActionUpdateSuper,
+		HistoryActionExpireSuper,
+		HistoryActionGrantRole,
+		HistoryActionRevokeRole:
+		return true
+	}
+	return false
+}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 // nolint
@@ -20,10 +23,27 @@ const (
 
 	// Query endpoints supported by the guardian querier
 	QuerySupers = "supers"
+
+	// DefaultParamspace is the default paramspace for the guardian module
+	DefaultParamspace = ModuleName
 )
 
 var (
-	SuperKey = []byte{0x00} // super key
+	SuperKey       = []byte{0x00} // super key
+	RoleKey        = []byte{0x01} // key for role grants by address
+	RoleAccountKey = []byte{0x02} // key for the index of role grants by role
+
+	SuperExpirationTimeKey   = []byte{0x03} // key for the queue of supers ordered by expiration time
+	SuperExpirationHeightKey = []byte{0x04} // key for the queue of supers ordered by expiration height
+
+	PendingActionKey      = []byte{0x05} // key for pending actions
+	PendingActionQueueKey = []byte{0x06} // key for the queue of pending actions ordered by deadline
+	NextActionIDKey       = []byte{0x07} // key for the id of the next pending action
+
+	SuperAddedByKey = []byte{0x08} // key for the index of supers by the address which added them
+
+	HistoryKey       = []byte{0x09} // key for the history records
+	NextHistoryIDKey = []byte{0x0a} // key for the id of the next history record
 )
 
 // GetSuperKey returns super key bytes
@@ -35,3 +55,73 @@ func GetSuperKey(addr sdk.AccAddress) []byte {
 func GetSupersSubspaceKey() []byte {
 	return SuperKey
 }
+
+// GetSuperAddedByKey returns the index key of the super added by the specified address
+func GetSuperAddedByKey(addedBy, addr sdk.AccAddress) []byte {
+	return append(GetSupersAddedBySubspaceKey(addedBy), addr.Bytes()...)
+}
+
+// GetSupersAddedBySubspaceKey returns the key for getting all supers added by the specified address
+func GetSupersAddedBySubspaceKey(addedBy sdk.AccAddress) []byte {
+	return append(SuperAddedByKey, address.MustLengthPrefix(addedBy)...)
+}
+
+// GetRoleKey returns the key of the role granted to the specified address
+func GetRoleKey(addr sdk.AccAddress, role string) []byte {
+	return append(GetAccountRolesSubspaceKey(addr), []byte(role)...)
+}
+
+// GetAccountRolesSubspaceKey returns the key for getting all roles of the specified address
+func GetAccountRolesSubspaceKey(addr sdk.AccAddress) []byte {
+	return append(RoleKey, address.MustLengthPrefix(addr)...)
+}
+
+// GetRoleAccountKey returns the index key of the specified address under the role
+func GetRoleAccountKey(role string, addr sdk.AccAddress) []byte {
+	return append(GetRoleAccountsSubspaceKey(role), addr.Bytes()...)
+}
+
+// GetRoleAccountsSubspaceKey returns the key for getting all addresses granted the role
+func GetRoleAccountsSubspaceKey(role string) []byte {
+	return append(RoleAccountKey, address.MustLengthPrefix([]byte(role))...)
+}
+
+// GetSuperExpirationTimeKey returns the queue key of the super expiring at the specified time
+func GetSuperExpirationTimeKey(expiration time.Time, addr sdk.AccAddress) []byte {
+	return append(GetSuperExpirationTimePrefix(expiration), addr.Bytes()...)
+}
+
+// GetSuperExpirationTimePrefix returns the queue prefix of the supers expiring at the specified time
+func GetSuperExpirationTimePrefix(expiration time.Time) []byte {
+	return append(SuperExpirationTimeKey, sdk.FormatTimeBytes(expiration)...)
+}
+
+// GetSuperExpirationHeightKey returns the queue key of the super expiring at the specified height
+func GetSuperExpirationHeightKey(height int64, addr sdk.AccAddress) []byte {
+	return append(GetSuperExpirationHeightPrefix(height), addr.Bytes()...)
+}
+
+// GetSuperExpirationHeightPrefix returns the queue prefix of the supers expiring at the specified height
+func GetSuperExpirationHeightPrefix(height int64) []byte {
+	return append(SuperExpirationHeightKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetPendingActionKey returns the key of the pending action with the specified id
+func GetPendingActionKey(id uint64) []byte {
+	return append(PendingActionKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPendingActionQueueKey returns the queue key of the pending action with the specified deadline
+func GetPendingActionQueueKey(deadline time.Time, id uint64) []byte {
+	return append(GetPendingActionQueuePrefix(deadline), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPendingActionQueuePrefix returns the queue prefix of the pending actions with the specified deadline
+func GetPendingActionQueuePrefix(deadline time.Time) []byte {
+	return append(PendingActionQueueKey, sdk.FormatTimeBytes(deadline)...)
+}
+
+// GetHistoryKey returns the key of the history record with the specified id
+func GetHistoryKey(id uint64) []byte {
+	return append(HistoryKey, sdk.Uint64ToBigEndian(id)...)
+}
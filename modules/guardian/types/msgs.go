@@ -6,13 +6,21 @@ import (
 )
 
 const (
-	TypeMsgAddSuper    = "add_super"    // type for MsgAddSuper
-	TypeMsgDeleteSuper = "delete_super" // type for MsgDeleteSuper
+	TypeMsgAddSuper      = "add_super"      // type for MsgAddSuper
+	TypeMsgDeleteSuper   = "delete_super"   // type for MsgDeleteSuper
+	TypeMsgUpdateSuper   = "update_super"   // type for MsgUpdateSuper
+	TypeMsgGrantRole     = "grant_role"     // type for MsgGrantRole
+	TypeMsgRevokeRole    = "revoke_role"    // type for MsgRevokeRole
+	TypeMsgApproveAction = "approve_action" // type for MsgApproveAction
 )
 
 var (
 	_ sdk.Msg = &MsgAddSuper{}
 	_ sdk.Msg = &MsgDeleteSuper{}
+	_ sdk.Msg = &MsgUpdateSuper{}
+	_ sdk.Msg = &MsgGrantRole{}
+	_ sdk.Msg = &MsgRevokeRole{}
+	_ sdk.Msg = &MsgApproveAction{}
 )
 
 // NewMsgAddSuper constructs a MsgAddSuper
@@ -50,6 +58,12 @@ func (msg MsgAddSuper) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.AddedBy); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
 	}
+	if msg.ExpirationHeight < 0 {
+		return sdkerrors.Wrapf(ErrInvalidExpiration, "expiration height must not be negative: %d", msg.ExpirationHeight)
+	}
+	if msg.Genesis && (msg.ExpirationHeight > 0 || msg.ExpirationTime != nil) {
+		return sdkerrors.Wrap(ErrInvalidExpiration, "genesis super can not expire")
+	}
 	if err := msg.EnsureLength(); err != nil {
 		return err
 	}
@@ -112,8 +126,207 @@ func (msg MsgDeleteSuper) GetSigners() []sdk.AccAddress {
 
 // EnsureLength validate the length of AddGuardian
 func (msg MsgAddSuper) EnsureLength() error {
-	if len(msg.Description) > 70 {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid website length; got: %d, max: %d", len(msg.Description), 70)
+	return ensureDescriptionLength(msg.Description)
+}
+
+// ______________________________________________________________________
+
+// NewMsgUpdateSuper constructs a MsgUpdateSuper
+func NewMsgUpdateSuper(address sdk.AccAddress, description string, genesis bool, updatedBy sdk.AccAddress) *MsgUpdateSuper {
+	return &MsgUpdateSuper{
+		Address:     address.String(),
+		Description: description,
+		Genesis:     genesis,
+		UpdatedBy:   updatedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgUpdateSuper) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgUpdateSuper) Type() string { return TypeMsgUpdateSuper }
+
+// GetSignBytes implements Msg.
+func (msg MsgUpdateSuper) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgUpdateSuper) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.UpdatedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if len(msg.Description) == 0 && !msg.Genesis {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nothing to update")
+	}
+	if err := msg.EnsureLength(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgUpdateSuper) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.UpdatedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// EnsureLength validate the length of UpdateSuper
+func (msg MsgUpdateSuper) EnsureLength() error {
+	return ensureDescriptionLength(msg.Description)
+}
+
+func ensureDescriptionLength(description string) error {
+	if len(description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid description length; got: %d, max: %d", len(description), MaxDescriptionLength)
+	}
+	return nil
+}
+
+// ______________________________________________________________________
+
+// NewMsgGrantRole constructs a MsgGrantRole
+func NewMsgGrantRole(address sdk.AccAddress, role string, grantedBy sdk.AccAddress) *MsgGrantRole {
+	return &MsgGrantRole{
+		Address:   address.String(),
+		Role:      role,
+		GrantedBy: grantedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgGrantRole) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgGrantRole) Type() string { return TypeMsgGrantRole }
+
+// GetSignBytes implements Msg.
+func (msg MsgGrantRole) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgGrantRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.GrantedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return ValidateRole(msg.Role)
+}
+
+// GetSigners implements Msg.
+func (msg MsgGrantRole) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.GrantedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgRevokeRole constructs a MsgRevokeRole
+func NewMsgRevokeRole(address sdk.AccAddress, role string, revokedBy sdk.AccAddress) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		Address:   address.String(),
+		Role:      role,
+		RevokedBy: revokedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRevokeRole) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRevokeRole) Type() string { return TypeMsgRevokeRole }
+
+// GetSignBytes implements Msg.
+func (msg MsgRevokeRole) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgRevokeRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.RevokedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return ValidateRole(msg.Role)
+}
+
+// GetSigners implements Msg.
+func (msg MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.RevokedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgApproveAction constructs a MsgApproveAction
+func NewMsgApproveAction(id uint64, approver sdk.AccAddress) *MsgApproveAction {
+	return &MsgApproveAction{
+		Id:       id,
+		Approver: approver.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgApproveAction) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgApproveAction) Type() string { return TypeMsgApproveAction }
+
+// GetSignBytes implements Msg.
+func (msg MsgApproveAction) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgApproveAction) ValidateBasic() error {
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrUnknownAction, "action id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Approver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
 	}
 	return nil
 }
+
+// GetSigners implements Msg.
+func (msg MsgApproveAction) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Approver)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -144,3 +145,165 @@ func TestMsgDeleteSuperValidation(t *testing.T) {
 		})
 	}
 }
+
+// ----------------------------------------------
+// test MsgUpdateSuper
+// ----------------------------------------------
+
+func TestNewMsgUpdateSuper(t *testing.T) {
+	msg := NewMsgUpdateSuper(testAddr, description, true, sender)
+	require.Equal(t, testAddr.String(), msg.Address)
+	require.Equal(t, description, msg.Description)
+	require.True(t, msg.Genesis)
+	require.Equal(t, sender.String(), msg.UpdatedBy)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgUpdateSuper, msg.Type())
+}
+
+// test ValidateBasic for MsgUpdateSuper
+func TestMsgUpdateSuperValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgUpdateSuper
+	}{
+		{"pass", true, NewMsgUpdateSuper(testAddr, description, false, sender)},
+		{"promote only", true, NewMsgUpdateSuper(testAddr, nilDescription, true, sender)},
+		{"nothing to update", false, NewMsgUpdateSuper(testAddr, nilDescription, false, sender)},
+		{"too long Description", false, NewMsgUpdateSuper(testAddr, strings.Repeat("d", MaxDescriptionLength+1), false, sender)},
+		{"invalid Address", false, NewMsgUpdateSuper(nilAddr, description, false, sender)},
+		{"invalid UpdatedBy", false, NewMsgUpdateSuper(testAddr, description, false, nilAddr)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+// test MsgGrantRole
+// ----------------------------------------------
+
+func TestNewMsgGrantRole(t *testing.T) {
+	msg := NewMsgGrantRole(testAddr, RoleOracleOperator, sender)
+	require.Equal(t, testAddr.String(), msg.Address)
+	require.Equal(t, RoleOracleOperator, msg.Role)
+	require.Equal(t, sender.String(), msg.GrantedBy)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgGrantRole, msg.Type())
+}
+
+func TestMsgGrantRoleGetSigners(t *testing.T) {
+	msg := NewMsgGrantRole(testAddr, RoleOracleOperator, sender)
+	res := msg.GetSigners()
+	expected := "[0A367B92CF0B037DFD89960EE832D56F7FC15168]"
+	require.Equal(t, expected, fmt.Sprintf("%v", res))
+}
+
+// test ValidateBasic for MsgGrantRole
+func TestMsgGrantRoleValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgGrantRole
+	}{
+		{"pass", true, NewMsgGrantRole(testAddr, RoleTokenAdmin, sender)},
+		{"custom role", true, NewMsgGrantRole(testAddr, "fee-admin2", sender)},
+		{"empty Role", false, NewMsgGrantRole(testAddr, "", sender)},
+		{"invalid Role", false, NewMsgGrantRole(testAddr, "Token_Admin", sender)},
+		{"invalid Address", false, NewMsgGrantRole(nilAddr, RoleTokenAdmin, sender)},
+		{"invalid GrantedBy", false, NewMsgGrantRole(testAddr, RoleTokenAdmin, nilAddr)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+// test MsgRevokeRole
+// ----------------------------------------------
+
+func TestNewMsgRevokeRole(t *testing.T) {
+	msg := NewMsgRevokeRole(testAddr, RoleUpgradeSigner, sender)
+	require.Equal(t, testAddr.String(), msg.Address)
+	require.Equal(t, RoleUpgradeSigner, msg.Role)
+	require.Equal(t, sender.String(), msg.RevokedBy)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgRevokeRole, msg.Type())
+}
+
+// test ValidateBasic for MsgRevokeRole
+func TestMsgRevokeRoleValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgRevokeRole
+	}{
+		{"pass", true, NewMsgRevokeRole(testAddr, RoleUpgradeSigner, sender)},
+		{"invalid Role", false, NewMsgRevokeRole(testAddr, "x", sender)},
+		{"invalid Address", false, NewMsgRevokeRole(nilAddr, RoleUpgradeSigner, sender)},
+		{"invalid RevokedBy", false, NewMsgRevokeRole(testAddr, RoleUpgradeSigner, nilAddr)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+// test MsgApproveAction
+// ----------------------------------------------
+
+func TestNewMsgApproveAction(t *testing.T) {
+	msg := NewMsgApproveAction(1, sender)
+	require.Equal(t, uint64(1), msg.Id)
+	require.Equal(t, sender.String(), msg.Approver)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgApproveAction, msg.Type())
+}
+
+// test ValidateBasic for MsgApproveAction
+func TestMsgApproveActionValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgApproveAction
+	}{
+		{"pass", true, NewMsgApproveAction(1, sender)},
+		{"invalid Id", false, NewMsgApproveAction(0, sender)},
+		{"invalid Approver", false, NewMsgApproveAction(1, nilAddr)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyApprovalThreshold = []byte("ApprovalThreshold")
+	KeyApprovalPeriod    = []byte("ApprovalPeriod")
+)
+
+// ParamKeyTable for guardian module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs a Params
+func NewParams(approvalThreshold uint32, approvalPeriod time.Duration) Params {
+	return Params{
+		ApprovalThreshold: approvalThreshold,
+		ApprovalPeriod:    approvalPeriod,
+	}
+}
+
+// DefaultParams returns default guardian module parameters, under which a single
+// genesis super can change the membership
+func DefaultParams() Params {
+	return Params{
+		ApprovalThreshold: 1,
+		ApprovalPeriod:    7 * 24 * time.Hour,
+	}
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyApprovalThreshold, &p.ApprovalThreshold, validateApprovalThreshold),
+		paramtypes.NewParamSetPair(KeyApprovalPeriod, &p.ApprovalPeriod, validateApprovalPeriod),
+	}
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateApprovalThreshold(p.ApprovalThreshold); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	if err := validateApprovalPeriod(p.ApprovalPeriod); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
+
+func validateApprovalThreshold(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("approval threshold must be positive")
+	}
+
+	return nil
+}
+
+func validateApprovalPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("approval period must be positive: %s", v)
+	}
+
+	return nil
+}
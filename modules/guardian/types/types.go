@@ -2,12 +2,28 @@ package types
 
 import (
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// Well-known roles which can be granted to an account
+const (
+	RoleOracleOperator = "oracle-operator"
+	RoleTokenAdmin     = "token-admin"
+	RoleUpgradeSigner  = "upgrade-signer"
+)
+
+// MaxDescriptionLength is the maximum length of the description of a super
+const MaxDescriptionLength = 70
+
+// role names are 3 ~ 32 lowercase characters, starting with a letter
+var reRole = regexp.MustCompile(`^[a-z][a-z0-9-]{2,31}$`)
+
 // NewSuper constructs a super
 func NewSuper(description string, accountType AccountType, address, addedBy sdk.AccAddress) Super {
 	return Super{
@@ -23,7 +39,29 @@ func (g Super) Equal(super Super) bool {
 	return g.Address == super.Address &&
 		g.AddedBy == super.AddedBy &&
 		g.Description == super.Description &&
-		g.AccountType == super.AccountType
+		g.AccountType == super.AccountType &&
+		g.ExpirationHeight == super.ExpirationHeight &&
+		equalTime(g.ExpirationTime, super.ExpirationTime)
+}
+
+// HasExpiration returns true if the super has an expiration height or time
+func (g Super) HasExpiration() bool {
+	return g.ExpirationHeight > 0 || g.ExpirationTime != nil
+}
+
+// IsExpired returns true if the super expires at or before the given block height or time
+func (g Super) IsExpired(height int64, blockTime time.Time) bool {
+	if g.ExpirationHeight > 0 && g.ExpirationHeight <= height {
+		return true
+	}
+	return g.ExpirationTime != nil && !g.ExpirationTime.After(blockTime)
+}
+
+func equalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
 }
 
 // AccountTypeFromString converts string to AccountType byte, Returns ff if invalid.
@@ -67,3 +105,20 @@ func (at AccountType) Format(s fmt.State, verb rune) {
 		s.Write([]byte(fmt.Sprintf("%v", byte(at))))
 	}
 }
+
+// NewRoleGrant constructs a role grant
+func NewRoleGrant(address sdk.AccAddress, role string, grantedBy sdk.AccAddress) RoleGrant {
+	return RoleGrant{
+		Address:   address.String(),
+		Role:      role,
+		GrantedBy: grantedBy.String(),
+	}
+}
+
+// ValidateRole checks if the given role name is valid
+func ValidateRole(role string) error {
+	if !reRole.MatchString(role) {
+		return sdkerrors.Wrapf(ErrInvalidRole, "invalid role %s, only accepts lowercase letters, numbers and '-', begin with a letter, length [3, 32]", role)
+	}
+	return nil
+}
@@ -21,9 +21,19 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	// Calculate block mint amount
 	params := k.GetParamSet(ctx)
+	switch params.InflationMode {
+	case types.InflationModeBondedRatio:
+		// the inflation rate is adjusted toward the goal bonded ratio
+		minter.Inflation = minter.NextInflationRate(params, k.BondedRatio(ctx), blockTime)
+		params.Inflation = minter.Inflation
+	default:
+		// the scheduled inflation rate overrides the flat one once it takes effect
+		params.Inflation = params.InflationAt(ctx.BlockHeight(), blockTime)
+	}
 	logger.Info("Mint parameters", "inflation_rate", params.Inflation.String(), "mint_denom", params.MintDenom)
 
-	mintedCoin := minter.BlockProvision(params)
+	// the supply never exceeds the max supply of the mint denom
+	mintedCoin := k.CapToMaxSupply(ctx, minter.ElapsedProvision(params, blockTime))
 	logger.Info("Mint result", "block_provisions", mintedCoin.String(), "time", blockTime.String())
 
 	mintedCoins := sdk.NewCoins(mintedCoin)
@@ -32,11 +42,43 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	if err := k.AddCollectedFees(ctx, mintedCoins); err != nil {
+	// send the minted coins to the destinations by the distribution proportions
+	if err := k.DistributeMintedCoins(ctx, params, mintedCoin); err != nil {
 		panic(err)
 	}
 
+	// mint the additional tokens at their fixed inflation rates
+	denomCoins := sdk.NewCoins()
+	for _, denomInflation := range params.DenomInflations {
+		// the tokens may have been changed after the params were set
+		if err := k.ValidateMintableDenom(ctx, denomInflation.Denom); err != nil {
+			logger.Error("Skip minting", "denom", denomInflation.Denom, "err", err.Error())
+			continue
+		}
+		denomCoin := k.CapToMaxSupply(ctx, minter.ElapsedDenomProvision(params, denomInflation, blockTime))
+		if err := k.MintCoins(ctx, sdk.NewCoins(denomCoin)); err != nil {
+			panic(err)
+		}
+		if err := k.DistributeMintedCoins(ctx, params, denomCoin); err != nil {
+			panic(err)
+		}
+		logger.Info("Mint result", "block_provisions", denomCoin.String(), "time", blockTime.String())
+		denomCoins = denomCoins.Add(denomCoin)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMint,
+				sdk.NewAttribute(types.AttributeKeyInflationTime, blockTime.String()),
+				sdk.NewAttribute(types.AttributeKeyMintCoin, denomCoin.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyMintDenom, denomCoin.Denom),
+				sdk.NewAttribute(types.AttributeKeyInflation, denomInflation.Inflation.String()),
+			),
+		)
+	}
+
+	// store the mint record of the block for the mint history
+	k.RecordMint(ctx, params, mintedCoin, denomCoins)
+
 	// Update last block BFT time
 	lastInflationTime := minter.LastUpdate
 	minter.LastUpdate = blockTime
@@ -48,6 +90,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			sdk.NewAttribute(types.AttributeKeyLastInflationTime, lastInflationTime.String()),
 			sdk.NewAttribute(types.AttributeKeyInflationTime, blockTime.String()),
 			sdk.NewAttribute(types.AttributeKeyMintCoin, mintedCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyMintDenom, mintedCoin.Denom),
+			sdk.NewAttribute(types.AttributeKeyInflation, params.Inflation.String()),
 		),
 	)
 }
@@ -2,30 +2,271 @@ package mint_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	tmcrypto "github.com/tendermint/tendermint/crypto"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
 	"github.com/irisnet/irishub/modules/mint"
 	"github.com/irisnet/irishub/modules/mint/types"
 	"github.com/irisnet/irishub/simapp"
 )
 
 func TestBeginBlocker(t *testing.T) {
+	lastUpdate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		minted  time.Duration
+	}{
+		{"fast chain", time.Second, time.Second},
+		{"normal chain", 5 * time.Second, 5 * time.Second},
+		{"slow chain", 30 * time.Second, 30 * time.Second},
+		// the provisions of a halted chain are capped by the max elapsed time
+		{"halted chain", 24 * time.Hour, types.DefaultMaxElapsedTime},
+		{"clock drifting backwards", -time.Second, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := createTestApp(t, true)
+			minter := types.NewMinter(lastUpdate, types.DefaultMinter().InflationBase)
+			app.MintKeeper.SetMinter(ctx, minter)
+			ctx = ctx.WithBlockTime(lastUpdate.Add(tc.elapsed))
+
+			mint.BeginBlocker(ctx, app.MintKeeper)
+
+			params := app.MintKeeper.GetParamSet(ctx)
+			expected := params.Inflation.MulInt(minter.InflationBase).
+				MulInt64(int64(tc.minted)).QuoInt64(int64(8766 * time.Hour)).TruncateInt()
+
+			acc := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
+			mintedCoins := app.BankKeeper.GetAllBalances(ctx, acc.GetAddress())
+			require.Equal(t, expected, mintedCoins.AmountOf(params.MintDenom))
+			require.Equal(t, ctx.BlockTime(), app.MintKeeper.GetMinter(ctx).LastUpdate)
+		})
+	}
+}
+
+func TestBeginBlockerInflationSchedule(t *testing.T) {
+	app, ctx := createTestApp(t, true)
+	lastUpdate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	minter := types.NewMinter(lastUpdate, types.DefaultMinter().InflationBase)
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.InflationSchedule = []types.InflationStep{
+		types.NewHeightInflationStep(ctx.BlockHeight(), sdk.NewDecWithPrec(8, 2)),
+	}
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	ctx = ctx.WithBlockTime(lastUpdate.Add(5 * time.Second))
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	// twice the provisions of the flat 4% inflation
+	params.Inflation = sdk.NewDecWithPrec(8, 2)
+	expected := minter.ElapsedProvision(params, ctx.BlockTime())
+	acc := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
+	require.Equal(t, expected.Amount, app.BankKeeper.GetAllBalances(ctx, acc.GetAddress()).AmountOf(params.MintDenom))
+}
+
+func TestBeginBlockerBondedRatio(t *testing.T) {
+	app, ctx := createTestApp(t, true)
+	lastUpdate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	minter := types.NewMinter(lastUpdate, types.DefaultMinter().InflationBase)
+	minter.Inflation = sdk.NewDecWithPrec(10, 2)
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.InflationMode = types.InflationModeBondedRatio
+	// the schedule is not followed in the bonded ratio mode
+	params.InflationSchedule = []types.InflationStep{
+		types.NewHeightInflationStep(ctx.BlockHeight(), sdk.NewDecWithPrec(8, 2)),
+	}
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	ctx = ctx.WithBlockTime(lastUpdate.Add(5 * time.Second))
+	bondedRatio := app.StakingKeeper.BondedRatio(ctx)
+	require.True(t, bondedRatio.LT(params.GoalBonded))
+
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	// the inflation rate rises while the bonded ratio is below the goal
+	inflation := app.MintKeeper.GetMinter(ctx).Inflation
+	require.Equal(t, minter.NextInflationRate(params, bondedRatio, ctx.BlockTime()), inflation)
+	require.True(t, inflation.GT(minter.Inflation))
+
+	params.Inflation = inflation
+	expected := minter.ElapsedProvision(params, ctx.BlockTime())
+	acc := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
+	require.Equal(t, expected.Amount, app.BankKeeper.GetAllBalances(ctx, acc.GetAddress()).AmountOf(params.MintDenom))
+}
+
+func TestBeginBlockerMaxSupply(t *testing.T) {
+	app, ctx := createTestApp(t, true)
+	lastUpdate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	minter := types.NewMinter(lastUpdate, types.DefaultMinter().InflationBase)
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.MintDenom = tokentypes.GetNativeToken().MinUnit
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	// leave less than the provisions of a block under the max supply
+	maxSupply, capped := app.MintKeeper.GetMaxSupply(ctx, params.MintDenom)
+	require.True(t, capped)
+	supply := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	remaining := sdk.NewInt(100)
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewCoin(params.MintDenom, maxSupply.Sub(supply).Sub(remaining)),
+	)))
+
+	ctx = ctx.WithBlockTime(lastUpdate.Add(5 * time.Second)).WithEventManager(sdk.NewEventManager())
+	require.True(t, minter.ElapsedProvision(params, ctx.BlockTime()).Amount.GT(remaining))
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	acc := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
+	require.Equal(t, remaining, app.BankKeeper.GetAllBalances(ctx, acc.GetAddress()).AmountOf(params.MintDenom))
+	require.Equal(t, maxSupply, app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	require.Contains(t, eventTypes(ctx.EventManager().Events()), types.EventTypeMaxSupplyReached)
+
+	// nothing is minted once the max supply is reached
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(5 * time.Second)).WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Equal(t, maxSupply, app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	require.NotContains(t, eventTypes(ctx.EventManager().Events()), types.EventTypeMaxSupplyReached)
+}
+
+func TestBeginBlockerHistory(t *testing.T) {
+	app, ctx := createTestApp(t, true)
+	lastUpdate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	app.MintKeeper.SetMinter(ctx, types.NewMinter(lastUpdate, types.DefaultMinter().InflationBase))
+
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.HistoryRetentionBlocks = 2
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	history := func() (records []types.MintRecord) {
+		app.MintKeeper.IterateMintRecords(ctx, func(record types.MintRecord) bool {
+			records = append(records, record)
+			return false
+		})
+		return
+	}
+
+	var minted []sdk.Int
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+		if i == 0 {
+			ctx = ctx.WithBlockTime(lastUpdate.Add(5 * time.Second))
+		}
+		acc := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
+		balance := app.BankKeeper.GetAllBalances(ctx, acc.GetAddress()).AmountOf(params.MintDenom)
+		mint.BeginBlocker(ctx, app.MintKeeper)
+		minted = append(minted, app.BankKeeper.GetAllBalances(ctx, acc.GetAddress()).AmountOf(params.MintDenom).Sub(balance))
+	}
+
+	// only the records of the latest 2 blocks are retained
+	records := history()
+	require.Len(t, records, 2)
+	for i, record := range records {
+		require.Equal(t, ctx.BlockHeight()-1+int64(i), record.Height)
+		require.Equal(t, minted[1+i], record.Amount.Amount)
+		require.Equal(t, params.Inflation, record.Inflation)
+	}
+	require.Equal(t, ctx.BlockTime(), records[1].Time)
+
+	// disabling the history prunes the records
+	params.HistoryRetentionBlocks = 0
+	app.MintKeeper.SetParamSet(ctx, params)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Empty(t, history())
+}
+
+func TestBeginBlockerDenomInflations(t *testing.T) {
 	app, ctx := createTestApp(t, true)
+	lastUpdate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	minter := types.NewMinter(lastUpdate, types.DefaultMinter().InflationBase)
+	app.MintKeeper.SetMinter(ctx, minter)
 
+	owner := sdk.AccAddress(tmcrypto.AddressHash([]byte("owner")))
+	require.NoError(t, app.TokenKeeper.AddToken(ctx, tokentypes.NewToken("atom", "Cosmos Hub", "uatom", 6, 1000, 0, true, owner)))
+	require.NoError(t, app.TokenKeeper.AddToken(ctx, tokentypes.NewToken("fixed", "Fixed Token", "ufixed", 6, 1000, 1000, false, owner)))
+
+	params := app.MintKeeper.GetParamSet(ctx)
+	atom := types.NewDenomInflation("uatom", sdk.NewDecWithPrec(10, 2), sdk.NewInt(1_000_000_000_000))
+	params.DenomInflations = []types.DenomInflation{
+		atom,
+		// the non-mintable and unknown tokens are skipped
+		types.NewDenomInflation("ufixed", sdk.NewDecWithPrec(10, 2), sdk.NewInt(1_000_000_000_000)),
+		types.NewDenomInflation("unknown", sdk.NewDecWithPrec(10, 2), sdk.NewInt(1_000_000_000_000)),
+		// the symbol of a token is not the denom of its coins
+		types.NewDenomInflation("atom", sdk.NewDecWithPrec(10, 2), sdk.NewInt(1_000_000_000_000)),
+	}
+	params.HistoryRetentionBlocks = 1
+	app.MintKeeper.SetParamSet(ctx, params)
+	require.NoError(t, app.MintKeeper.ValidateMintableDenom(ctx, "uatom"))
+	require.ErrorIs(t, app.MintKeeper.ValidateMintableDenom(ctx, "ufixed"), types.ErrInvalidDenomInflation)
+	require.ErrorIs(t, app.MintKeeper.ValidateMintableDenom(ctx, "unknown"), types.ErrInvalidDenomInflation)
+	require.ErrorIs(t, app.MintKeeper.ValidateMintableDenom(ctx, "atom"), types.ErrInvalidDenomInflation)
+	require.ErrorIs(t, app.MintKeeper.ValidateParams(ctx, params), types.ErrInvalidDenomInflation)
+	_, capped := app.MintKeeper.GetMaxSupply(ctx, "atom")
+	require.False(t, capped)
+
+	ctx = ctx.WithBlockTime(lastUpdate.Add(5 * time.Second))
+	supply := app.BankKeeper.GetSupply(ctx, "ufixed").Amount
 	mint.BeginBlocker(ctx, app.MintKeeper)
-	minter := app.MintKeeper.GetMinter(ctx)
-	param := app.MintKeeper.GetParamSet(ctx)
-	mintCoins := minter.BlockProvision(param)
 
-	acc1 := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
-	mintedCoins := app.BankKeeper.GetAllBalances(ctx, acc1.GetAddress())
-	require.Equal(t, mintedCoins, sdk.NewCoins(mintCoins))
+	acc := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
+	balances := app.BankKeeper.GetAllBalances(ctx, acc.GetAddress())
+	require.Equal(t, minter.ElapsedProvision(params, ctx.BlockTime()).Amount, balances.AmountOf(params.MintDenom))
+	require.Equal(t, minter.ElapsedDenomProvision(params, atom, ctx.BlockTime()), sdk.NewCoin("uatom", balances.AmountOf("uatom")))
+	require.True(t, balances.AmountOf("uatom").IsPositive())
+	require.True(t, balances.AmountOf("ufixed").IsZero())
+	require.True(t, balances.AmountOf("unknown").IsZero())
+	require.True(t, balances.AmountOf("atom").IsZero())
+	require.Equal(t, supply, app.BankKeeper.GetSupply(ctx, "ufixed").Amount)
+
+	// the minted coins of the additional denoms are recorded in the mint history
+	var records []types.MintRecord
+	app.MintKeeper.IterateMintRecords(ctx, func(record types.MintRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	require.Len(t, records, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uatom", balances.AmountOf("uatom"))), records[0].DenomAmounts)
+
+	// the mint events of all the denoms carry the amount and the denom in separate attributes
+	var mintEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeMint {
+			continue
+		}
+		mintEvents++
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		require.Contains(t, []string{params.MintDenom, "uatom"}, attrs[types.AttributeKeyMintDenom])
+		require.Equal(t, balances.AmountOf(attrs[types.AttributeKeyMintDenom]).String(), attrs[types.AttributeKeyMintCoin])
+	}
+	require.Equal(t, 2, mintEvents)
+}
+
+func eventTypes(events sdk.Events) []string {
+	res := make([]string, len(events))
+	for i, event := range events {
+		res[i] = event.Type
+	}
+	return res
 }
 
 // returns context and an app with updated mint keeper
@@ -36,6 +277,8 @@ func createTestApp(t *testing.T, isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	app.MintKeeper.SetParamSet(ctx, types.NewParams(
 		sdk.DefaultBondDenom,
 		sdk.NewDecWithPrec(4, 2),
+		types.DefaultMaxElapsedTime,
+		types.DefaultBlocksPerYear,
 	))
 	app.MintKeeper.SetMinter(ctx, types.DefaultMinter())
 	app.DistrKeeper.SetFeePool(ctx, distributiontypes.InitialFeePool())
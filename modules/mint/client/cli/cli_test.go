@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 
@@ -54,4 +55,30 @@ func (s *IntegrationTestSuite) TestMint() {
 	params := respType.(*minttypes.Params)
 	s.Require().Equal("stake", params.MintDenom)
 	s.Require().Equal("0.040000000000000000", params.Inflation.String())
+
+	//------test GetCmdQueryMinter()-------------
+	respType = proto.Message(&minttypes.Minter{})
+	bz, err = minttestutil.QueryMinterExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType))
+	minter := respType.(*minttypes.Minter)
+	s.Require().Equal(minttypes.DefaultMinter().InflationBase, minter.InflationBase)
+
+	//------test GetCmdQueryAnnualProvisions()-------------
+	respType = proto.Message(&minttypes.QueryAnnualProvisionsResponse{})
+	bz, err = minttestutil.QueryAnnualProvisionsExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType))
+	annualProvisions := respType.(*minttypes.QueryAnnualProvisionsResponse)
+	s.Require().Equal(params.Inflation, annualProvisions.Inflation)
+	s.Require().Equal(params.Inflation.MulInt(minter.InflationBase), annualProvisions.AnnualProvisions)
+
+	//------test GetCmdQueryBlockProvision()-------------
+	respType = proto.Message(&sdk.Coin{})
+	bz, err = minttestutil.QueryBlockProvisionExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType))
+	blockProvision := respType.(*sdk.Coin)
+	s.Require().Equal(params.MintDenom, blockProvision.Denom)
+	s.Require().True(blockProvision.Amount.IsPositive())
 }
@@ -0,0 +1,23 @@
+// nolint
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagRebaseToSupply = "rebase-to-supply"
+	FlagDeposit        = "deposit"
+	FlagMetadata       = "metadata"
+)
+
+// common flagsets to add to various functions
+var (
+	FsUpdateInflationBase = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	FsUpdateInflationBase.Bool(FlagRebaseToSupply, false, "rebase the inflation base to the current supply of the mint denom")
+	FsUpdateInflationBase.String(FlagDeposit, "", "deposit of the proposal")
+	FsUpdateInflationBase.String(FlagMetadata, "", "metadata of the proposal")
+}
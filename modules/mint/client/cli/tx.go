@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// NewTxCmd returns the transaction commands for the mint module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "mint transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdUpdateInflationBase(),
+	)
+	return txCmd
+}
+
+// GetCmdUpdateInflationBase implements the command submitting a governance proposal
+// to update the inflation base.
+func GetCmdUpdateInflationBase() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-inflation-base [inflation-base]",
+		Short: "Submit a governance proposal to update the inflation base of the minter",
+		Example: fmt.Sprintf(
+			"%s tx mint update-inflation-base 2000000000000000 --deposit=1000iris --chain-id=<chain-id> --from=<key-name> --fees=0.3iris\n"+
+				"%s tx mint update-inflation-base --rebase-to-supply --deposit=1000iris --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName, version.AppName,
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rebaseToSupply, _ := cmd.Flags().GetBool(FlagRebaseToSupply)
+			if rebaseToSupply == (len(args) == 1) {
+				return fmt.Errorf("either the inflation base or the --%s flag is required", FlagRebaseToSupply)
+			}
+			inflationBase := sdk.ZeroInt()
+			if len(args) == 1 {
+				var ok bool
+				if inflationBase, ok = sdk.NewIntFromString(args[0]); !ok {
+					return fmt.Errorf("invalid inflation base: %s", args[0])
+				}
+			}
+
+			authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			msg := types.NewMsgUpdateInflationBase(authority, inflationBase, rebaseToSupply)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			depositStr, _ := cmd.Flags().GetString(FlagDeposit)
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			metadata, _ := cmd.Flags().GetString(FlagMetadata)
+
+			proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsUpdateInflationBase)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
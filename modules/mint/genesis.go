@@ -1,7 +1,6 @@
 package mint
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/irisnet/irishub/modules/mint/keeper"
@@ -15,22 +14,38 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 	if err := ValidateGenesis(data); err != nil {
 		panic(fmt.Errorf("failed to initialize mint genesis state: %s", err.Error()))
 	}
+	// the tokens of the denom inflations are initialized by the token module beforehand
+	if err := keeper.ValidateParams(ctx, data.Params); err != nil {
+		panic(fmt.Errorf("failed to initialize mint genesis state: %s", err.Error()))
+	}
 	keeper.SetMinter(ctx, data.Minter)
 	keeper.SetParamSet(ctx, data.Params)
+	for _, record := range data.History {
+		keeper.SetMintRecord(ctx, record)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParamSet(ctx)
-	return types.NewGenesisState(minter, params)
+
+	history := []types.MintRecord{}
+	keeper.IterateMintRecords(ctx, func(record types.MintRecord) bool {
+		history = append(history, record)
+		return false
+	})
+	return types.NewGenesisState(minter, params, history)
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data types.GenesisState) error {
-	if !data.Minter.InflationBase.IsPositive() {
-		return errors.New("base inflation must be positive")
+	if err := types.ValidateMinter(data.Minter); err != nil {
+		return err
+	}
+	if err := types.ValidateMintRecords(data.History); err != nil {
+		return err
 	}
 	return data.Params.Validate()
 }
@@ -0,0 +1,43 @@
+package mint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/mint"
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	withMinter := func(modify func(minter *types.Minter)) types.GenesisState {
+		genesis := types.DefaultGenesisState()
+		modify(&genesis.Minter)
+		return *genesis
+	}
+
+	tests := []struct {
+		name       string
+		genesis    types.GenesisState
+		expectPass bool
+	}{
+		{"default", *types.DefaultGenesisState(), true},
+		{"zero inflation", withMinter(func(minter *types.Minter) { minter.Inflation = sdk.ZeroDec() }), true},
+		{"nil inflation", withMinter(func(minter *types.Minter) { minter.Inflation = sdk.Dec{} }), false},
+		{"negative inflation", withMinter(func(minter *types.Minter) { minter.Inflation = sdk.NewDecWithPrec(-1, 2) }), false},
+		{"inflation above one", withMinter(func(minter *types.Minter) { minter.Inflation = sdk.NewDecWithPrec(11, 1) }), false},
+		{"zero inflation base", withMinter(func(minter *types.Minter) { minter.InflationBase = sdk.ZeroInt() }), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := mint.ValidateGenesis(tc.genesis)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -3,7 +3,12 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 )
@@ -17,3 +22,92 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// InflationSchedule queries the current inflation rate and the upcoming scheduled changes
+func (k Keeper) InflationSchedule(c context.Context, _ *types.QueryInflationScheduleRequest) (*types.QueryInflationScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+	inflation := k.GetInflation(ctx, params, k.GetMinter(ctx))
+
+	// the inflation schedule is not followed in the bonded ratio mode
+	if params.InflationMode == types.InflationModeBondedRatio {
+		return &types.QueryInflationScheduleResponse{
+			Inflation:     inflation,
+			UpcomingSteps: []types.InflationStep{},
+		}, nil
+	}
+
+	return &types.QueryInflationScheduleResponse{
+		Inflation:     inflation,
+		UpcomingSteps: params.UpcomingSteps(ctx.BlockHeight(), ctx.BlockTime()),
+	}, nil
+}
+
+// RemainingSupply queries the supply of the mint denom which can still be minted under its max supply
+func (k Keeper) RemainingSupply(c context.Context, _ *types.QueryRemainingSupplyRequest) (*types.QueryRemainingSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	denom := k.GetParamSet(ctx).MintDenom
+
+	maxSupply, capped := k.GetMaxSupply(ctx, denom)
+	if !capped {
+		return nil, status.Errorf(codes.NotFound, "mint denom %s has no max supply", denom)
+	}
+	remaining, _ := k.GetRemainingSupply(ctx, denom)
+
+	return &types.QueryRemainingSupplyResponse{
+		MaxSupply: sdk.NewCoin(denom, maxSupply),
+		Supply:    k.bankKeeper.GetSupply(ctx, denom),
+		Remaining: sdk.NewCoin(denom, remaining),
+	}, nil
+}
+
+// Minter queries the minter state
+func (k Keeper) Minter(c context.Context, _ *types.QueryMinterRequest) (*types.QueryMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMinterResponse{Minter: k.GetMinter(ctx)}, nil
+}
+
+// AnnualProvisions queries the annual provisions at the current inflation rate
+func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisionsRequest) (*types.QueryAnnualProvisionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+	minter := k.GetMinter(ctx)
+	params.Inflation = k.GetInflation(ctx, params, minter)
+
+	return &types.QueryAnnualProvisionsResponse{
+		AnnualProvisions: minter.NextAnnualProvisions(params),
+		Inflation:        params.Inflation,
+	}, nil
+}
+
+// BlockProvision queries the estimated provision of a block at the current inflation rate
+func (k Keeper) BlockProvision(c context.Context, _ *types.QueryBlockProvisionRequest) (*types.QueryBlockProvisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+	minter := k.GetMinter(ctx)
+	params.Inflation = k.GetInflation(ctx, params, minter)
+
+	return &types.QueryBlockProvisionResponse{BlockProvision: minter.BlockProvision(params)}, nil
+}
+
+// History queries the mint records of the latest blocks
+func (k Keeper) History(c context.Context, req *types.QueryHistoryRequest) (*types.QueryHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var records []types.MintRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintRecordKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var record types.MintRecord
+		k.cdc.MustUnmarshal(value, &record)
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -2,8 +2,14 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 )
@@ -20,3 +26,114 @@ func (suite *KeeperTestSuite) TestGRPCQueryPoolParameters() {
 	suite.NoError(err)
 	suite.Equal(app.MintKeeper.GetParamSet(ctx), resp.Params)
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryInflationSchedule() {
+	app, ctx := suite.app, suite.ctx.WithBlockHeight(10)
+
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.InflationSchedule = []types.InflationStep{
+		types.NewHeightInflationStep(5, sdk.NewDecWithPrec(8, 2)),
+		types.NewHeightInflationStep(20, sdk.NewDecWithPrec(2, 2)),
+	}
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.MintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	resp, err := queryClient.InflationSchedule(gocontext.Background(), &types.QueryInflationScheduleRequest{})
+	suite.NoError(err)
+	suite.Equal(sdk.NewDecWithPrec(8, 2), resp.Inflation)
+	suite.Equal(params.InflationSchedule[1:], resp.UpcomingSteps)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryRemainingSupply() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.MintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	// the supply of a denom which is not a token is not capped
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.MintDenom = "unknown"
+	app.MintKeeper.SetParamSet(ctx, params)
+	_, err := queryClient.RemainingSupply(gocontext.Background(), &types.QueryRemainingSupplyRequest{})
+	suite.Error(err)
+
+	params.MintDenom = tokentypes.GetNativeToken().MinUnit
+	app.MintKeeper.SetParamSet(ctx, params)
+	minted := sdk.NewCoin(params.MintDenom, sdk.NewInt(1000))
+	suite.NoError(app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(minted)))
+
+	resp, err := queryClient.RemainingSupply(gocontext.Background(), &types.QueryRemainingSupplyRequest{})
+	suite.NoError(err)
+	suite.Equal(app.BankKeeper.GetSupply(ctx, params.MintDenom), resp.Supply)
+	suite.Equal(resp.MaxSupply.Sub(resp.Supply), resp.Remaining)
+	suite.Equal(sdkmath.NewIntWithDecimal(int64(tokentypes.GetNativeToken().MaxSupply), 6), resp.MaxSupply.Amount)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryProvisions() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.MintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	minterResp, err := queryClient.Minter(gocontext.Background(), &types.QueryMinterRequest{})
+	suite.NoError(err)
+	suite.Equal(app.MintKeeper.GetMinter(ctx), minterResp.Minter)
+
+	params := app.MintKeeper.GetParamSet(ctx)
+	annualResp, err := queryClient.AnnualProvisions(gocontext.Background(), &types.QueryAnnualProvisionsRequest{})
+	suite.NoError(err)
+	suite.Equal(params.Inflation, annualResp.Inflation)
+	suite.Equal(minterResp.Minter.NextAnnualProvisions(params), annualResp.AnnualProvisions)
+
+	blockResp, err := queryClient.BlockProvision(gocontext.Background(), &types.QueryBlockProvisionRequest{})
+	suite.NoError(err)
+	suite.Equal(minterResp.Minter.BlockProvision(params), blockResp.BlockProvision)
+
+	// the provisions follow the inflation rate of the minter in the bonded ratio mode
+	params.InflationMode = types.InflationModeBondedRatio
+	app.MintKeeper.SetParamSet(ctx, params)
+	minter := minterResp.Minter
+	minter.Inflation = sdk.NewDecWithPrec(10, 2)
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	annualResp, err = queryClient.AnnualProvisions(gocontext.Background(), &types.QueryAnnualProvisionsRequest{})
+	suite.NoError(err)
+	suite.Equal(minter.Inflation, annualResp.Inflation)
+	suite.Equal(minter.Inflation.MulInt(minter.InflationBase), annualResp.AnnualProvisions)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryHistory() {
+	app, ctx := suite.app, suite.ctx
+
+	var records []types.MintRecord
+	for height := int64(1); height <= 3; height++ {
+		record := types.MintRecord{
+			Height:    height,
+			Time:      time.Date(2022, 1, 1, 0, 0, int(height)*5, 0, time.UTC),
+			Amount:    sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(height*100)),
+			Inflation: sdk.NewDecWithPrec(4, 2),
+		}
+		app.MintKeeper.SetMintRecord(ctx, record)
+		records = append(records, record)
+	}
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.MintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	resp, err := queryClient.History(gocontext.Background(), &types.QueryHistoryRequest{})
+	suite.NoError(err)
+	suite.Equal(records, resp.Records)
+
+	resp, err = queryClient.History(gocontext.Background(), &types.QueryHistoryRequest{
+		Pagination: &query.PageRequest{Limit: 2, Reverse: true, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Equal([]types.MintRecord{records[2], records[1]}, resp.Records)
+	suite.Equal(uint64(3), resp.Pagination.Total)
+}
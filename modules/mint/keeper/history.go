@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// RecordMint stores the mint record of the current block, with the coins of the mint denom and
+// of the denom inflations, and prunes the records falling out of the retention window of params
+func (k Keeper) RecordMint(ctx sdk.Context, params types.Params, mintedCoin sdk.Coin, denomCoins sdk.Coins) {
+	height := ctx.BlockHeight()
+	if params.HistoryRetentionBlocks > 0 {
+		k.SetMintRecord(ctx, types.MintRecord{
+			Height:       height,
+			Time:         ctx.BlockTime(),
+			Amount:       mintedCoin,
+			Inflation:    params.Inflation,
+			DenomAmounts: denomCoins,
+		})
+	}
+	k.PruneMintRecords(ctx, height-int64(params.HistoryRetentionBlocks))
+}
+
+// SetMintRecord stores the mint record
+func (k Keeper) SetMintRecord(ctx sdk.Context, record types.MintRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetMintRecordKey(record.Height), bz)
+}
+
+// IterateMintRecords iterates through the mint records by increasing heights
+func (k Keeper) IterateMintRecords(ctx sdk.Context, op func(record types.MintRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MintRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MintRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if stop := op(record); stop {
+			break
+		}
+	}
+}
+
+// PruneMintRecords deletes the mint records up to the specified height
+func (k Keeper) PruneMintRecords(ctx sdk.Context, height int64) {
+	if height <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.MintRecordKey, types.GetMintRecordKey(height+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -5,11 +5,16 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
 	"github.com/irisnet/irishub/modules/mint/types"
 )
 
@@ -18,14 +23,20 @@ type Keeper struct {
 	cdc              codec.Codec
 	storeKey         storetypes.StoreKey
 	paramSpace       paramtypes.Subspace
+	stakingKeeper    types.StakingKeeper
+	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	tokenKeeper      types.TokenKeeper
+	distrKeeper      types.DistrKeeper
 	feeCollectorName string
+	// the address capable of executing governance operations, usually the gov module account
+	authority string
 }
 
 // NewKeeper returns a mint keeper
 func NewKeeper(cdc codec.Codec, key storetypes.StoreKey,
-	paramSpace paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string) Keeper {
+	paramSpace paramtypes.Subspace, sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper,
+	tk types.TokenKeeper, dk types.DistrKeeper, feeCollectorName string, authority string) Keeper {
 
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -36,8 +47,13 @@ func NewKeeper(cdc codec.Codec, key storetypes.StoreKey,
 		storeKey:         key,
 		cdc:              cdc,
 		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		stakingKeeper:    sk,
+		accountKeeper:    ak,
 		bankKeeper:       bk,
+		tokenKeeper:      tk,
+		distrKeeper:      dk,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 	return keeper
 }
@@ -47,6 +63,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
 }
 
+// GetAuthority returns the mint module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // ______________________________________________________________________
 
 // GetMinter returns the minter
@@ -67,6 +88,12 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store.Set(types.MinterKey, b)
 }
 
+// BondedRatio implements an alias call to the underlying staking keeper's
+// BondedRatio to be used in BeginBlocker.
+func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
+	return k.stakingKeeper.BondedRatio(ctx)
+}
+
 // MintCoins implements an alias call to the underlying supply keeper's
 // MintCoins to be used in BeginBlocker.
 func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
@@ -83,6 +110,161 @@ func (k Keeper) AddCollectedFees(ctx sdk.Context, coins sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, coins)
 }
 
+// GetInflation returns the inflation rate in effect at the current block, which is the rate
+// stored in the minter in the bonded ratio mode, or the scheduled or flat rate of params otherwise
+func (k Keeper) GetInflation(ctx sdk.Context, params types.Params, minter types.Minter) sdk.Dec {
+	if params.InflationMode == types.InflationModeBondedRatio {
+		return minter.Inflation
+	}
+	return params.InflationAt(ctx.BlockHeight(), ctx.BlockTime())
+}
+
+// DistributeMintedCoins sends the minted coins to the destinations by the distribution proportions.
+// The fee collector receives the remainder of the truncated proportions.
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, params types.Params, mintedCoin sdk.Coin) error {
+	proportions := params.DistributionProportions
+	portion := func(proportion sdk.Dec) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, proportion.MulInt(mintedCoin.Amount).TruncateInt()))
+	}
+
+	communityPoolCoins := portion(proportions.CommunityPool)
+	developerFundCoins := portion(proportions.DeveloperFund)
+	burnCoins := portion(proportions.Burn)
+	feeCollectorCoins := sdk.NewCoins(mintedCoin).
+		Sub(communityPoolCoins...).
+		Sub(developerFundCoins...).
+		Sub(burnCoins...)
+
+	if !communityPoolCoins.Empty() {
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPoolCoins, moduleAddr); err != nil {
+			return err
+		}
+		emitDistributionEvent(ctx, types.DestinationCommunityPool, communityPoolCoins)
+	}
+
+	if !developerFundCoins.Empty() {
+		developerFund, err := sdk.AccAddressFromBech32(params.DeveloperFundAddress)
+		if err != nil {
+			// the developer fund address may be missing after a param change, which must not halt the chain
+			k.Logger(ctx).Error("invalid developer fund address, sending the developer fund to the fee collector", "address", params.DeveloperFundAddress)
+			feeCollectorCoins = feeCollectorCoins.Add(developerFundCoins...)
+		} else {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, developerFund, developerFundCoins); err != nil {
+				return err
+			}
+			emitDistributionEvent(ctx, types.DestinationDeveloperFund, developerFundCoins)
+		}
+	}
+
+	if !burnCoins.Empty() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+			return err
+		}
+		emitDistributionEvent(ctx, types.DestinationBurn, burnCoins)
+	}
+
+	if !feeCollectorCoins.Empty() {
+		if err := k.AddCollectedFees(ctx, feeCollectorCoins); err != nil {
+			return err
+		}
+		emitDistributionEvent(ctx, types.DestinationFeeCollector, feeCollectorCoins)
+	}
+	return nil
+}
+
+func emitDistributionEvent(ctx sdk.Context, destination string, coins sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintDistribution,
+			sdk.NewAttribute(types.AttributeKeyDestination, destination),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	)
+}
+
+// getToken returns the token whose min unit is the given denom. The token keeper also looks up
+// tokens by symbol, which is not the denom of the coins of the token.
+func (k Keeper) getToken(ctx sdk.Context, denom string) (tokentypes.TokenI, error) {
+	token, err := k.tokenKeeper.GetToken(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	if token.GetMinUnit() != denom {
+		return nil, fmt.Errorf("denom %s is not the min unit %s of token %s", denom, token.GetMinUnit(), token.GetSymbol())
+	}
+	return token, nil
+}
+
+// GetMaxSupply returns the max supply in the min unit of the token of the given denom.
+// The supply of a denom which is not the min unit of a token is not capped.
+func (k Keeper) GetMaxSupply(ctx sdk.Context, denom string) (maxSupply sdk.Int, capped bool) {
+	token, err := k.getToken(ctx, denom)
+	if err != nil {
+		return sdk.ZeroInt(), false
+	}
+	maxSupply = sdk.NewIntFromUint64(token.GetMaxSupply()).Mul(sdkmath.NewIntWithDecimal(1, int(token.GetScale())))
+	return maxSupply, true
+}
+
+// CapToMaxSupply caps the coin to be minted by the remaining supply under the max supply of
+// its token, and emits an event when the max supply is reached
+func (k Keeper) CapToMaxSupply(ctx sdk.Context, coin sdk.Coin) sdk.Coin {
+	remaining, capped := k.GetRemainingSupply(ctx, coin.Denom)
+	if !capped || coin.Amount.LT(remaining) {
+		return coin
+	}
+	if remaining.IsPositive() {
+		maxSupply, _ := k.GetMaxSupply(ctx, coin.Denom)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMaxSupplyReached,
+				sdk.NewAttribute(types.AttributeKeyMaxSupply, sdk.NewCoin(coin.Denom, maxSupply).String()),
+			),
+		)
+	}
+	return sdk.NewCoin(coin.Denom, remaining)
+}
+
+// ValidateMintableDenom returns err if the denom is not the min unit of a mintable token of the token module
+func (k Keeper) ValidateMintableDenom(ctx sdk.Context, denom string) error {
+	token, err := k.getToken(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDenomInflation, err.Error())
+	}
+	if !token.GetMintable() {
+		return sdkerrors.Wrapf(types.ErrInvalidDenomInflation, "token %s is not mintable", token.GetSymbol())
+	}
+	return nil
+}
+
+// ValidateParams returns err if the params are invalid, including the denom inflations of the
+// tokens which don't exist or are not mintable, which can't be checked by Params.Validate alone
+func (k Keeper) ValidateParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	for _, denomInflation := range params.DenomInflations {
+		if err := k.ValidateMintableDenom(ctx, denomInflation.Denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRemainingSupply returns the supply of the given denom which can still be minted under its max supply
+func (k Keeper) GetRemainingSupply(ctx sdk.Context, denom string) (remaining sdk.Int, capped bool) {
+	maxSupply, capped := k.GetMaxSupply(ctx, denom)
+	if !capped {
+		return sdk.ZeroInt(), false
+	}
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if supply.GTE(maxSupply) {
+		return sdk.ZeroInt(), true
+	}
+	return maxSupply.Sub(supply), true
+}
+
 // GetParamSet returns inflation params from the global param store
 func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
 	var params types.Params
@@ -7,10 +7,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	tmcrypto "github.com/tendermint/tendermint/crypto"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/irisnet/irishub/modules/mint/keeper"
 	"github.com/irisnet/irishub/modules/mint/types"
 	"github.com/irisnet/irishub/simapp"
 )
@@ -89,3 +91,101 @@ func (suite *KeeperTestSuite) TestAddCollectedFees() {
 	require.Equal(suite.T(), expectedCollectedFees, mintCoins)
 
 }
+
+func (suite *KeeperTestSuite) TestDistributeMintedCoins() {
+	developerFund := sdk.AccAddress(tmcrypto.AddressHash([]byte("developer_fund")))
+	params := types.DefaultParams()
+	params.DistributionProportions = types.NewDistributionProportions(
+		sdk.NewDecWithPrec(5, 1),
+		sdk.NewDecWithPrec(2, 1),
+		sdk.NewDecWithPrec(2, 1),
+		sdk.NewDecWithPrec(1, 1),
+	)
+	params.DeveloperFundAddress = developerFund.String()
+	suite.NoError(params.Validate())
+
+	mintedCoin := sdk.NewCoin("iris", sdk.NewInt(1001))
+	suite.NoError(suite.app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(mintedCoin)))
+	feeCollector := suite.app.AccountKeeper.GetModuleAccount(suite.ctx, "fee_collector")
+	feeCollectorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector.GetAddress(), "iris")
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf("iris")
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, "iris")
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.NoError(suite.app.MintKeeper.DistributeMintedCoins(suite.ctx, params, mintedCoin))
+
+	// the fee collector receives the remainder of the truncated proportions
+	suite.Equal(sdk.NewInt(501), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector.GetAddress(), "iris").Amount.Sub(feeCollectorBalance.Amount))
+	suite.Equal(sdk.NewDec(200), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf("iris").Sub(communityPool))
+	suite.Equal(sdk.NewInt(200), suite.app.BankKeeper.GetBalance(suite.ctx, developerFund, "iris").Amount)
+	suite.Equal(supply.Amount.SubRaw(100), suite.app.BankKeeper.GetSupply(suite.ctx, "iris").Amount)
+
+	var destinations []string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMintDistribution {
+			destinations = append(destinations, string(event.Attributes[0].Value))
+		}
+	}
+	suite.Equal([]string{
+		types.DestinationCommunityPool,
+		types.DestinationDeveloperFund,
+		types.DestinationBurn,
+		types.DestinationFeeCollector,
+	}, destinations)
+}
+
+func (suite *KeeperTestSuite) TestUpdateInflationBase() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.app.MintKeeper)
+	authority := suite.app.MintKeeper.GetAuthority()
+
+	// only the authority updates the inflation base
+	other := sdk.AccAddress(tmcrypto.AddressHash([]byte("other"))).String()
+	_, err := msgServer.UpdateInflationBase(ctx, types.NewMsgUpdateInflationBase(other, sdk.NewInt(100), false))
+	suite.ErrorIs(err, types.ErrInvalidAuthority)
+
+	_, err = msgServer.UpdateInflationBase(ctx, types.NewMsgUpdateInflationBase(authority, sdk.NewInt(100), false))
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(100), suite.app.MintKeeper.GetMinter(suite.ctx).InflationBase)
+
+	// rebase to the current supply of the mint denom
+	params := suite.app.MintKeeper.GetParamSet(suite.ctx)
+	suite.NoError(suite.app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(1000)))))
+	_, err = msgServer.UpdateInflationBase(ctx, types.NewMsgUpdateInflationBase(authority, sdk.ZeroInt(), true))
+	suite.NoError(err)
+	suite.Equal(suite.app.BankKeeper.GetSupply(suite.ctx, params.MintDenom).Amount, suite.app.MintKeeper.GetMinter(suite.ctx).InflationBase)
+
+	// the minter is validated
+	params.MintDenom = "empty"
+	suite.app.MintKeeper.SetParamSet(suite.ctx, params)
+	_, err = msgServer.UpdateInflationBase(ctx, types.NewMsgUpdateInflationBase(authority, sdk.ZeroInt(), true))
+	suite.ErrorIs(err, types.ErrInvalidMinter)
+}
+
+func TestValidateDistributionProportions(t *testing.T) {
+	tests := []struct {
+		name        string
+		proportions types.DistributionProportions
+		expectPass  bool
+	}{
+		{"default", types.DefaultDistributionProportions(), true},
+		{"split", types.NewDistributionProportions(sdk.NewDecWithPrec(7, 1), sdk.NewDecWithPrec(3, 1), sdk.ZeroDec(), sdk.ZeroDec()), true},
+		{"sum below 1", types.NewDistributionProportions(sdk.NewDecWithPrec(7, 1), sdk.NewDecWithPrec(2, 1), sdk.ZeroDec(), sdk.ZeroDec()), false},
+		{"sum above 1", types.NewDistributionProportions(sdk.OneDec(), sdk.NewDecWithPrec(1, 1), sdk.ZeroDec(), sdk.ZeroDec()), false},
+		{"negative", types.NewDistributionProportions(sdk.NewDecWithPrec(11, 1), sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewDecWithPrec(-1, 1)), false},
+	}
+	for _, tc := range tests {
+		params := types.DefaultParams()
+		params.DistributionProportions = tc.proportions
+		if tc.expectPass {
+			require.NoError(t, params.Validate(), tc.name)
+		} else {
+			require.ErrorIs(t, params.Validate(), types.ErrInvalidDistributionProportions, tc.name)
+		}
+	}
+
+	// the developer fund needs an address
+	params := types.DefaultParams()
+	params.DistributionProportions = types.NewDistributionProportions(sdk.NewDecWithPrec(9, 1), sdk.ZeroDec(), sdk.NewDecWithPrec(1, 1), sdk.ZeroDec())
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidDistributionProportions)
+}
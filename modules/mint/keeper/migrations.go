@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2 by initializing the max elapsed time param.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxElapsedTime, types.DefaultMaxElapsedTime)
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3 by initializing the blocks per year param
+// with the value which was hard-coded before.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyBlocksPerYear, types.DefaultBlocksPerYear)
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4 by initializing an empty inflation schedule.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyInflationSchedule, []types.InflationStep{})
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5 by initializing the params of the bonded ratio
+// inflation mode, the fixed mode stays in use.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyInflationMode, types.InflationModeFixed)
+	m.keeper.paramSpace.Set(ctx, types.KeyInflationRateChange, types.DefaultInflationRateChange)
+	m.keeper.paramSpace.Set(ctx, types.KeyInflationMax, types.DefaultInflationMax)
+	m.keeper.paramSpace.Set(ctx, types.KeyInflationMin, types.DefaultInflationMin)
+	m.keeper.paramSpace.Set(ctx, types.KeyGoalBonded, types.DefaultGoalBonded)
+
+	// the bonded ratio mode starts from the current inflation rate
+	minter := m.keeper.GetMinter(ctx)
+	var inflation sdk.Dec
+	m.keeper.paramSpace.Get(ctx, types.KeyInflation, &inflation)
+	minter.Inflation = inflation
+	m.keeper.SetMinter(ctx, minter)
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6 by initializing the distribution proportions which
+// send all the minted coins to the fee collector as before, and by granting the mint module
+// account the permission to burn the minted coins.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyDistributionProportions, types.DefaultDistributionProportions())
+	m.keeper.paramSpace.Set(ctx, types.KeyDeveloperFundAddress, "")
+
+	acc := m.keeper.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	moduleAcc, ok := acc.(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("invalid %s module account type: %T", types.ModuleName, acc)
+	}
+	if !moduleAcc.HasPermission(authtypes.Burner) {
+		moduleAcc.Permissions = append(moduleAcc.Permissions, authtypes.Burner)
+		m.keeper.accountKeeper.SetModuleAccount(ctx, moduleAcc)
+	}
+	return nil
+}
+
+// Migrate6to7 migrates from version 6 to 7 by initializing the history retention param
+// which disables the mint history.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyHistoryRetentionBlocks, uint64(0))
+	return nil
+}
+
+// Migrate7to8 migrates from version 7 to 8 by initializing an empty list of denom inflations.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyDenomInflations, []types.DenomInflation{})
+	return nil
+}
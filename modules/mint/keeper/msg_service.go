@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the mint MsgServer interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (m msgServer) UpdateInflationBase(goCtx context.Context, msg *types.MsgUpdateInflationBase) (*types.MsgUpdateInflationBaseResponse, error) {
+	if msg.Authority != m.Keeper.GetAuthority() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", m.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	minter := m.Keeper.GetMinter(ctx)
+	previousInflationBase := minter.InflationBase
+
+	minter.InflationBase = msg.InflationBase
+	if msg.RebaseToSupply {
+		minter.InflationBase = m.Keeper.bankKeeper.GetSupply(ctx, m.Keeper.GetParamSet(ctx).MintDenom).Amount
+	}
+	if err := types.ValidateMinter(minter); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidMinter, err.Error())
+	}
+	m.Keeper.SetMinter(ctx, minter)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+		sdk.NewEvent(
+			types.EventTypeUpdateInflationBase,
+			sdk.NewAttribute(types.AttributeKeyPreviousInflationBase, previousInflationBase.String()),
+			sdk.NewAttribute(types.AttributeKeyInflationBase, minter.InflationBase.String()),
+		),
+	})
+
+	return &types.MsgUpdateInflationBaseResponse{}, nil
+}
@@ -0,0 +1,46 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/irisnet/irishub/modules/mint/keeper"
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// NewParamChangeProposalHandler wraps the param change proposal handler to validate the mint params
+// as a whole after the change, which the per-key param validation can't do: the rules across params,
+// e.g. the min inflation not exceeding the max inflation, and the denom inflations against the token
+// module. The proposal leaving the mint params invalid fails without changing them.
+func NewParamChangeProposalHandler(k keeper.Keeper, handler govv1beta1.Handler) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		if !changesMintParams(content) {
+			return handler(ctx, content)
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := handler(cacheCtx, content); err != nil {
+			return err
+		}
+		if err := k.ValidateParams(cacheCtx, k.GetParamSet(cacheCtx)); err != nil {
+			return err
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return nil
+	}
+}
+
+func changesMintParams(content govv1beta1.Content) bool {
+	proposal, ok := content.(*paramproposal.ParameterChangeProposal)
+	if !ok {
+		return false
+	}
+	for _, change := range proposal.Changes {
+		if change.Subspace == types.ModuleName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package mint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	"github.com/irisnet/irishub/modules/mint"
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	app, ctx := createTestApp(t, false)
+	owner := sdk.AccAddress(tmcrypto.AddressHash([]byte("owner")))
+	require.NoError(t, app.TokenKeeper.AddToken(ctx, tokentypes.NewToken("atom", "Cosmos Hub", "uatom", 6, 1000, 0, true, owner)))
+	handler := mint.NewParamChangeProposalHandler(app.MintKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+
+	changeDenomInflations := func(denom string) *paramproposal.ParameterChangeProposal {
+		value := `[{"denom":"` + denom + `","inflation":"0.100000000000000000","inflation_base":"1000000000000"}]`
+		return paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(types.ModuleName, string(types.KeyDenomInflations), value),
+		})
+	}
+
+	// the symbol of the token is refused and the params are left unchanged
+	require.ErrorIs(t, handler(ctx, changeDenomInflations("atom")), types.ErrInvalidDenomInflation)
+	require.Empty(t, app.MintKeeper.GetParamSet(ctx).DenomInflations)
+	require.ErrorIs(t, handler(ctx, changeDenomInflations("unknown")), types.ErrInvalidDenomInflation)
+	require.Empty(t, app.MintKeeper.GetParamSet(ctx).DenomInflations)
+
+	require.NoError(t, handler(ctx, changeDenomInflations("uatom")))
+	require.Equal(t, []types.DenomInflation{
+		types.NewDenomInflation("uatom", sdk.NewDecWithPrec(10, 2), sdk.NewInt(1_000_000_000_000)),
+	}, app.MintKeeper.GetParamSet(ctx).DenomInflations)
+
+	// a change of a single param breaking the rules across params is refused as a whole
+	change := func(key, value string) *paramproposal.ParameterChangeProposal {
+		return paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(types.ModuleName, key, value),
+		})
+	}
+	before := app.MintKeeper.GetParamSet(ctx)
+	require.ErrorIs(t, handler(ctx, change(string(types.KeyInflationMax), `"`+before.InflationMin.Sub(sdk.NewDecWithPrec(1, 2)).String()+`"`)), types.ErrInvalidInflationMode)
+	require.ErrorIs(t, handler(ctx, change(string(types.KeyDistributionProportions), `{"fee_collector":"0.900000000000000000","community_pool":"0.000000000000000000","developer_fund":"0.100000000000000000","burn":"0.000000000000000000"}`)), types.ErrInvalidDistributionProportions)
+	require.Equal(t, before, app.MintKeeper.GetParamSet(ctx))
+
+	require.NoError(t, handler(ctx, change(string(types.KeyInflationMin), `"`+before.InflationMax.String()+`"`)))
+	require.Equal(t, before.InflationMax, app.MintKeeper.GetParamSet(ctx).InflationMin)
+}
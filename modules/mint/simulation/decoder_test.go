@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/irisnet/irishub/modules/mint/simulation"
@@ -19,10 +20,17 @@ func TestDecodeStore(t *testing.T) {
 	minter := types.NewMinter(time.Now().UTC(), sdkmath.NewIntWithDecimal(2, 9))
 	cdc, _ := simapp.MakeCodecs()
 	dec := simulation.NewDecodeStore(cdc)
+	record := types.MintRecord{
+		Height:    10,
+		Time:      time.Now().UTC(),
+		Amount:    sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+		Inflation: sdk.NewDecWithPrec(4, 2),
+	}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.MinterKey, Value: cdc.MustMarshal(&minter)},
+			{Key: types.GetMintRecordKey(record.Height), Value: cdc.MustMarshal(&record)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -31,6 +39,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"MintRecord", fmt.Sprintf("%v\n%v", record, record)},
 		{"other", ""},
 	}
 
@@ -9,13 +9,15 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 )
 
 // Simulation parameter constants
 const (
-	Inflation = "inflation"
+	Inflation     = "inflation"
+	BlocksPerYear = "blocks_per_year"
 )
 
 // GenInflation randomized Inflation
@@ -23,6 +25,11 @@ func GenInflation(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
 }
 
+// GenBlocksPerYear randomized BlocksPerYear
+func GenBlocksPerYear(r *rand.Rand) uint64 {
+	return uint64(60 * 60 * 8766 / simtypes.RandIntBetween(r, 1, 30))
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -32,8 +39,14 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { inflation = GenInflation(r) },
 	)
 
-	params := types.Params{Inflation: inflation, MintDenom: types.MintDenom}
-	mintGenesis := types.NewGenesisState(types.DefaultMinter(), params)
+	var blocksPerYear uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BlocksPerYear, &blocksPerYear, simState.Rand,
+		func(r *rand.Rand) { blocksPerYear = GenBlocksPerYear(r) },
+	)
+
+	params := types.NewParams(types.MintDenom, inflation, types.DefaultMaxElapsedTime, blocksPerYear)
+	mintGenesis := types.NewGenesisState(types.DefaultMinter(), params, []types.MintRecord{})
 
 	bz, err := json.MarshalIndent(&mintGenesis, "", " ")
 	if err != nil {
@@ -23,5 +23,12 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenInflation(r))
 			},
 		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyBlocksPerYear),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBlocksPerYear(r))
+			},
+		),
 	}
 }
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomInflation creates a new DenomInflation instance
+func NewDenomInflation(denom string, inflation sdk.Dec, inflationBase sdk.Int) DenomInflation {
+	return DenomInflation{
+		Denom:         denom,
+		Inflation:     inflation,
+		InflationBase: inflationBase,
+	}
+}
+
+// Validate returns err if the DenomInflation is invalid
+func (d DenomInflation) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return err
+	}
+	if err := validateInflation(d.Inflation); err != nil {
+		return err
+	}
+	if d.InflationBase.IsNil() || !d.InflationBase.IsPositive() {
+		return fmt.Errorf("inflation base [%s] of %s should be positive", d.InflationBase, d.Denom)
+	}
+	return nil
+}
+
+// AnnualProvisions gets the annual provisions of the token
+func (d DenomInflation) AnnualProvisions() sdk.Dec {
+	return d.Inflation.MulInt(d.InflationBase)
+}
+
+func validateDenomInflations(i interface{}) error {
+	v, ok := i.([]DenomInflation)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, d := range v {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		if seen[d.Denom] {
+			return fmt.Errorf("duplicate denom inflation of %s", d.Denom)
+		}
+		seen[d.Denom] = true
+	}
+	return nil
+}
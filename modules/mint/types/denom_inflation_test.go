@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateDenomInflations(t *testing.T) {
+	atom := NewDenomInflation("uatom", sdk.NewDecWithPrec(10, 2), sdk.NewInt(1000))
+
+	tests := []struct {
+		name            string
+		denomInflations []DenomInflation
+		expectPass      bool
+	}{
+		{"empty", nil, true},
+		{"pass", []DenomInflation{atom}, true},
+		{"invalid denom", []DenomInflation{NewDenomInflation("a", sdk.NewDecWithPrec(10, 2), sdk.NewInt(1000))}, false},
+		{"inflation too high", []DenomInflation{NewDenomInflation("uatom", sdk.NewDecWithPrec(21, 2), sdk.NewInt(1000))}, false},
+		{"negative inflation", []DenomInflation{NewDenomInflation("uatom", sdk.NewDecWithPrec(-1, 2), sdk.NewInt(1000))}, false},
+		{"zero inflation base", []DenomInflation{NewDenomInflation("uatom", sdk.NewDecWithPrec(10, 2), sdk.ZeroInt())}, false},
+		{"nil inflation base", []DenomInflation{NewDenomInflation("uatom", sdk.NewDecWithPrec(10, 2), sdk.Int{})}, false},
+		{"duplicate denom", []DenomInflation{atom, atom}, false},
+		{"mint denom", []DenomInflation{NewDenomInflation(DefaultParams().MintDenom, sdk.NewDecWithPrec(10, 2), sdk.NewInt(1000))}, false},
+	}
+	for _, tc := range tests {
+		params := DefaultParams()
+		params.DenomInflations = tc.denomInflations
+		if tc.expectPass {
+			require.NoError(t, params.Validate(), tc.name)
+		} else {
+			require.Error(t, params.Validate(), tc.name)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// destinations of the minted coins
+const (
+	DestinationFeeCollector  = "fee_collector"
+	DestinationCommunityPool = "community_pool"
+	DestinationDeveloperFund = "developer_fund"
+	DestinationBurn          = "burn"
+)
+
+// NewDistributionProportions creates a new DistributionProportions instance
+func NewDistributionProportions(feeCollector, communityPool, developerFund, burn sdk.Dec) DistributionProportions {
+	return DistributionProportions{
+		FeeCollector:  feeCollector,
+		CommunityPool: communityPool,
+		DeveloperFund: developerFund,
+		Burn:          burn,
+	}
+}
+
+// DefaultDistributionProportions returns the proportions sending all the minted coins to the fee collector
+func DefaultDistributionProportions() DistributionProportions {
+	return NewDistributionProportions(sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+}
+
+// Validate returns err if the DistributionProportions is invalid
+func (p DistributionProportions) Validate() error {
+	proportions := []struct {
+		destination string
+		proportion  sdk.Dec
+	}{
+		{DestinationFeeCollector, p.FeeCollector},
+		{DestinationCommunityPool, p.CommunityPool},
+		{DestinationDeveloperFund, p.DeveloperFund},
+		{DestinationBurn, p.Burn},
+	}
+
+	total := sdk.ZeroDec()
+	for _, d := range proportions {
+		if d.proportion.IsNil() || d.proportion.IsNegative() || d.proportion.GT(sdk.OneDec()) {
+			return fmt.Errorf("%s proportion [%s] should be between [0, 1]", d.destination, d.proportion)
+		}
+		total = total.Add(d.proportion)
+	}
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("distribution proportions should sum to 1, got [%s]", total)
+	}
+	return nil
+}
@@ -8,4 +8,17 @@ import (
 var (
 	ErrInvalidMintInflation = sdkerrors.Register(ModuleName, 2, "invalid mint inflation")
 	ErrInvalidMintDenom     = sdkerrors.Register(ModuleName, 3, "invalid mint denom")
+
+	ErrInvalidMaxElapsedTime = sdkerrors.Register(ModuleName, 4, "invalid max elapsed time")
+	ErrInvalidBlocksPerYear  = sdkerrors.Register(ModuleName, 5, "invalid blocks per year")
+
+	ErrInvalidInflationSchedule = sdkerrors.Register(ModuleName, 6, "invalid inflation schedule")
+	ErrInvalidInflationMode     = sdkerrors.Register(ModuleName, 7, "invalid inflation mode")
+
+	ErrInvalidDistributionProportions = sdkerrors.Register(ModuleName, 8, "invalid distribution proportions")
+
+	ErrInvalidAuthority = sdkerrors.Register(ModuleName, 9, "invalid authority")
+	ErrInvalidMinter    = sdkerrors.Register(ModuleName, 10, "invalid minter")
+
+	ErrInvalidDenomInflation = sdkerrors.Register(ModuleName, 11, "invalid denom inflation")
 )
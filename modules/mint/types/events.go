@@ -3,9 +3,18 @@ package types
 
 // mint module event types
 const (
-	EventTypeMint = "mint"
+	EventTypeMint                = "mint"
+	EventTypeMaxSupplyReached    = "max_supply_reached"
+	EventTypeMintDistribution    = "mint_distribution"
+	EventTypeUpdateInflationBase = "update_inflation_base"
 
-	AttributeKeyLastInflationTime = "last_inflation_time"
-	AttributeKeyInflationTime     = "inflation_time"
-	AttributeKeyMintCoin          = "mint_coin"
+	AttributeKeyLastInflationTime     = "last_inflation_time"
+	AttributeKeyInflationTime         = "inflation_time"
+	AttributeKeyMintCoin              = "mint_coin"
+	AttributeKeyMintDenom             = "mint_denom"
+	AttributeKeyInflation             = "inflation"
+	AttributeKeyMaxSupply             = "max_supply"
+	AttributeKeyDestination           = "destination"
+	AttributeKeyInflationBase         = "inflation_base"
+	AttributeKeyPreviousInflationBase = "previous_inflation_base"
 )
@@ -1,18 +1,24 @@
 package types
 
+import (
+	"fmt"
+)
+
 // NewGenesisState constructs a GenesisState
-func NewGenesisState(minter Minter, params Params) *GenesisState {
+func NewGenesisState(minter Minter, params Params, history []MintRecord) *GenesisState {
 	return &GenesisState{
-		Minter: minter,
-		Params: params,
+		Minter:  minter,
+		Params:  params,
+		History: history,
 	}
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Minter: DefaultMinter(),
-		Params: DefaultParams(),
+		Minter:  DefaultMinter(),
+		Params:  DefaultParams(),
+		History: []MintRecord{},
 	}
 }
 
@@ -22,5 +28,29 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	if err := ValidateMintRecords(data.History); err != nil {
+		return err
+	}
 	return ValidateMinter(data.Minter)
 }
+
+// ValidateMintRecords returns err if the mint records are invalid or not ordered by increasing heights
+func ValidateMintRecords(records []MintRecord) error {
+	var lastHeight int64
+	for _, record := range records {
+		if record.Height <= lastHeight {
+			return fmt.Errorf("mint record heights should be positive and increasing, got %d after %d", record.Height, lastHeight)
+		}
+		if err := record.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid amount of the mint record at height %d: %s", record.Height, err)
+		}
+		if err := record.DenomAmounts.Validate(); err != nil {
+			return fmt.Errorf("invalid denom amounts of the mint record at height %d: %s", record.Height, err)
+		}
+		if record.Inflation.IsNil() || record.Inflation.IsNegative() {
+			return fmt.Errorf("invalid inflation of the mint record at height %d: %s", record.Height, record.Inflation)
+		}
+		lastHeight = record.Height
+	}
+	return nil
+}
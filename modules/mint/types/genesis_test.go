@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateMintRecords(t *testing.T) {
+	record := func(height int64) MintRecord {
+		return MintRecord{
+			Height:    height,
+			Time:      time.Unix(height*5, 0).UTC(),
+			Amount:    sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+			Inflation: sdk.NewDecWithPrec(4, 2),
+		}
+	}
+	invalidInflation := record(3)
+	invalidInflation.Inflation = sdk.Dec{}
+
+	tests := []struct {
+		name       string
+		records    []MintRecord
+		expectPass bool
+	}{
+		{"empty", nil, true},
+		{"increasing heights", []MintRecord{record(1), record(2)}, true},
+		{"zero height", []MintRecord{record(0)}, false},
+		{"duplicate height", []MintRecord{record(1), record(1)}, false},
+		{"decreasing heights", []MintRecord{record(2), record(1)}, false},
+		{"invalid inflation", []MintRecord{invalidInflation}, false},
+	}
+	for _, tc := range tests {
+		genesis := DefaultGenesisState()
+		genesis.History = tc.records
+		if tc.expectPass {
+			require.NoError(t, ValidateGenesis(*genesis), tc.name)
+		} else {
+			require.Error(t, ValidateGenesis(*genesis), tc.name)
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // nolint
 const (
 	// ModuleName defines the module name
@@ -21,5 +25,11 @@ const (
 
 var (
 	// use for the keeper store
-	MinterKey = []byte{0x00}
+	MinterKey     = []byte{0x00}
+	MintRecordKey = []byte{0x01} // key for the mint records by block height
 )
+
+// GetMintRecordKey returns the key of the mint record of the specified block height
+func GetMintRecordKey(height int64) []byte {
+	return append(MintRecordKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
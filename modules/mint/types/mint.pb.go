@@ -6,9 +6,11 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
@@ -28,12 +30,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationMode defines how the inflation rate is determined
+type InflationMode int32
+
+const (
+	// FIXED defines a fixed inflation rate, optionally following the inflation schedule
+	InflationModeFixed InflationMode = 0
+	// BONDED_RATIO defines an inflation rate adjusted toward the goal bonded ratio
+	InflationModeBondedRatio InflationMode = 1
+)
+
+var InflationMode_name = map[int32]string{
+	0: "FIXED",
+	1: "BONDED_RATIO",
+}
+
+var InflationMode_value = map[string]int32{
+	"FIXED":        0,
+	"BONDED_RATIO": 1,
+}
+
+func (x InflationMode) String() string {
+	return proto.EnumName(InflationMode_name, int32(x))
+}
+
+func (InflationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{0}
+}
+
 // Minter represents the minting state
 type Minter struct {
 	// time which the last update was made to the minter
 	LastUpdate time.Time `protobuf:"bytes,1,opt,name=last_update,json=lastUpdate,proto3,stdtime" json:"last_update" yaml:"last_update"`
 	// base inflation
 	InflationBase github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=inflation_base,json=inflationBase,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflation_base" yaml:"inflation_base"`
+	// current inflation rate of the bonded ratio inflation mode
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -82,6 +114,31 @@ type Params struct {
 	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
 	// inflation rate
 	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// maximum elapsed time since the last update for which the provisions of a block are minted
+	MaxElapsedTime time.Duration `protobuf:"bytes,3,opt,name=max_elapsed_time,json=maxElapsedTime,proto3,stdduration" json:"max_elapsed_time" yaml:"max_elapsed_time"`
+	// expected number of blocks produced per year, only used to estimate the provision of a block
+	// by the BlockProvision query; the provisions actually minted follow the elapsed block time
+	BlocksPerYear uint64 `protobuf:"varint,4,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// scheduled inflation rates overriding the inflation rate once they take effect
+	InflationSchedule []InflationStep `protobuf:"bytes,5,rep,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule" yaml:"inflation_schedule"`
+	// mode in which the inflation rate is determined
+	InflationMode InflationMode `protobuf:"varint,6,opt,name=inflation_mode,json=inflationMode,proto3,enum=irishub.mint.InflationMode" json:"inflation_mode,omitempty" yaml:"inflation_mode"`
+	// maximum annual change of the inflation rate in the bonded ratio mode
+	InflationRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate_change" yaml:"inflation_rate_change"`
+	// maximum inflation rate in the bonded ratio mode
+	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max" yaml:"inflation_max"`
+	// minimum inflation rate in the bonded ratio mode
+	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min" yaml:"inflation_min"`
+	// bonded ratio targeted by the bonded ratio mode
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
+	// proportions of the minted coins sent to each destination
+	DistributionProportions DistributionProportions `protobuf:"bytes,11,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions" yaml:"distribution_proportions"`
+	// address receiving the developer fund proportion of the minted coins
+	DeveloperFundAddress string `protobuf:"bytes,12,opt,name=developer_fund_address,json=developerFundAddress,proto3" json:"developer_fund_address,omitempty" yaml:"developer_fund_address"`
+	// number of the latest blocks whose mint records are retained, 0 disables the mint history
+	HistoryRetentionBlocks uint64 `protobuf:"varint,13,opt,name=history_retention_blocks,json=historyRetentionBlocks,proto3" json:"history_retention_blocks,omitempty" yaml:"history_retention_blocks"`
+	// additional tokens minted at fixed inflation rates
+	DenomInflations []DenomInflation `protobuf:"bytes,14,rep,name=denom_inflations,json=denomInflations,proto3" json:"denom_inflations" yaml:"denom_inflations"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -123,38 +180,401 @@ func (m *Params) GetMintDenom() string {
 	return ""
 }
 
+func (m *Params) GetMaxElapsedTime() time.Duration {
+	if m != nil {
+		return m.MaxElapsedTime
+	}
+	return 0
+}
+
+func (m *Params) GetBlocksPerYear() uint64 {
+	if m != nil {
+		return m.BlocksPerYear
+	}
+	return 0
+}
+
+func (m *Params) GetInflationSchedule() []InflationStep {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetInflationMode() InflationMode {
+	if m != nil {
+		return m.InflationMode
+	}
+	return InflationModeFixed
+}
+
+func (m *Params) GetDistributionProportions() DistributionProportions {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return DistributionProportions{}
+}
+
+func (m *Params) GetDeveloperFundAddress() string {
+	if m != nil {
+		return m.DeveloperFundAddress
+	}
+	return ""
+}
+
+func (m *Params) GetHistoryRetentionBlocks() uint64 {
+	if m != nil {
+		return m.HistoryRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDenomInflations() []DenomInflation {
+	if m != nil {
+		return m.DenomInflations
+	}
+	return nil
+}
+
+// DenomInflation defines the fixed inflation of an additional token minted besides the mint denom
+type DenomInflation struct {
+	// denom of the token, which must be a mintable token of the token module
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// inflation rate of the token
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// base amount the inflation rate applies to
+	InflationBase github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=inflation_base,json=inflationBase,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflation_base" yaml:"inflation_base"`
+}
+
+func (m *DenomInflation) Reset()         { *m = DenomInflation{} }
+func (m *DenomInflation) String() string { return proto.CompactTextString(m) }
+func (*DenomInflation) ProtoMessage()    {}
+func (*DenomInflation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{2}
+}
+func (m *DenomInflation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomInflation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomInflation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomInflation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomInflation.Merge(m, src)
+}
+func (m *DenomInflation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomInflation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomInflation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomInflation proto.InternalMessageInfo
+
+func (m *DenomInflation) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DistributionProportions defines the proportions of the minted coins sent to each destination, which sum to 1
+type DistributionProportions struct {
+	// proportion sent to the fee collector
+	FeeCollector github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=fee_collector,json=feeCollector,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_collector" yaml:"fee_collector"`
+	// proportion sent to the community pool
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	// proportion sent to the developer fund address
+	DeveloperFund github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=developer_fund,json=developerFund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_fund" yaml:"developer_fund"`
+	// proportion burned
+	Burn github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=burn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn"`
+}
+
+func (m *DistributionProportions) Reset()         { *m = DistributionProportions{} }
+func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
+func (*DistributionProportions) ProtoMessage()    {}
+func (*DistributionProportions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{3}
+}
+func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProportions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProportions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProportions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProportions.Merge(m, src)
+}
+func (m *DistributionProportions) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProportions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProportions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
+
+// InflationStep defines an inflation rate which takes effect from a block height or a block time
+type InflationStep struct {
+	// block height from which the step takes effect, exclusive with start_time
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// block time from which the step takes effect, exclusive with start_height
+	StartTime *time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty" yaml:"start_time"`
+	// inflation rate of the step
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// factor applied to the inflation rate at the end of every decay period, e.g. 0.5 for halvings
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// number of blocks of a decay period of a step starting from a block height
+	DecayBlocks int64 `protobuf:"varint,5,opt,name=decay_blocks,json=decayBlocks,proto3" json:"decay_blocks,omitempty" yaml:"decay_blocks"`
+	// duration of a decay period of a step starting from a block time
+	DecayPeriod time.Duration `protobuf:"bytes,6,opt,name=decay_period,json=decayPeriod,proto3,stdduration" json:"decay_period" yaml:"decay_period"`
+}
+
+func (m *InflationStep) Reset()         { *m = InflationStep{} }
+func (m *InflationStep) String() string { return proto.CompactTextString(m) }
+func (*InflationStep) ProtoMessage()    {}
+func (*InflationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{4}
+}
+func (m *InflationStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationStep.Merge(m, src)
+}
+func (m *InflationStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationStep proto.InternalMessageInfo
+
+func (m *InflationStep) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *InflationStep) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *InflationStep) GetDecayBlocks() int64 {
+	if m != nil {
+		return m.DecayBlocks
+	}
+	return 0
+}
+
+func (m *InflationStep) GetDecayPeriod() time.Duration {
+	if m != nil {
+		return m.DecayPeriod
+	}
+	return 0
+}
+
+// MintRecord defines the provisions minted in a block
+type MintRecord struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time of the block
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// coins minted in the block
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// inflation rate of the block
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// coins of the additional denoms minted in the block at their denom inflations
+	DenomAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=denom_amounts,json=denomAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_amounts"`
+}
+
+func (m *MintRecord) Reset()         { *m = MintRecord{} }
+func (m *MintRecord) String() string { return proto.CompactTextString(m) }
+func (*MintRecord) ProtoMessage()    {}
+func (*MintRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{5}
+}
+func (m *MintRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRecord.Merge(m, src)
+}
+func (m *MintRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRecord proto.InternalMessageInfo
+
+func (m *MintRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MintRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *MintRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MintRecord) GetDenomAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomAmounts
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("irishub.mint.InflationMode", InflationMode_name, InflationMode_value)
 	proto.RegisterType((*Minter)(nil), "irishub.mint.Minter")
 	proto.RegisterType((*Params)(nil), "irishub.mint.Params")
+	proto.RegisterType((*DenomInflation)(nil), "irishub.mint.DenomInflation")
+	proto.RegisterType((*DistributionProportions)(nil), "irishub.mint.DistributionProportions")
+	proto.RegisterType((*InflationStep)(nil), "irishub.mint.InflationStep")
+	proto.RegisterType((*MintRecord)(nil), "irishub.mint.MintRecord")
 }
 
 func init() { proto.RegisterFile("mint/mint.proto", fileDescriptor_e1b9fbb701b2a577) }
 
 var fileDescriptor_e1b9fbb701b2a577 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x33, 0x97, 0x4b, 0x21, 0xd3, 0x7b, 0x15, 0x82, 0x42, 0x29, 0x98, 0x94, 0x2c, 0xa4,
-	0x9b, 0xce, 0x80, 0xee, 0xba, 0x0c, 0x85, 0x22, 0x28, 0x48, 0xd0, 0x8d, 0x2e, 0xca, 0xa4, 0x99,
-	0xc6, 0xc1, 0xcc, 0x4c, 0xc8, 0x4c, 0x16, 0xc5, 0x97, 0xe8, 0xd2, 0xa5, 0x8f, 0xd3, 0x65, 0x37,
-	0x82, 0xb8, 0x88, 0xd2, 0xbe, 0x41, 0x9f, 0x40, 0x26, 0x69, 0x6b, 0x5d, 0xba, 0x49, 0xe6, 0xfc,
-	0x73, 0xce, 0x37, 0xe7, 0x9c, 0x1f, 0x1e, 0x72, 0x26, 0x34, 0x36, 0x1f, 0x94, 0xe5, 0x52, 0x4b,
-	0xe7, 0x1f, 0xcb, 0x99, 0x7a, 0x28, 0x22, 0x64, 0xb4, 0xf6, 0x51, 0x22, 0x13, 0x59, 0x5d, 0x60,
-	0x73, 0xaa, 0x73, 0xda, 0x5e, 0x22, 0x65, 0x92, 0x52, 0x5c, 0x45, 0x51, 0x31, 0xc1, 0x9a, 0x71,
-	0xaa, 0x34, 0xe1, 0x59, 0x9d, 0xe0, 0xbf, 0x02, 0xd8, 0xb8, 0x62, 0x42, 0xd3, 0xdc, 0xb9, 0x87,
-	0xcd, 0x94, 0x28, 0x3d, 0x2a, 0xb2, 0x98, 0x68, 0xda, 0x02, 0x1d, 0xd0, 0x6d, 0x9e, 0xb5, 0x51,
-	0x4d, 0x40, 0x5b, 0x02, 0xba, 0xd9, 0x12, 0x02, 0x77, 0x5e, 0x7a, 0xd6, 0xba, 0xf4, 0x9c, 0x29,
-	0xe1, 0x69, 0xdf, 0xdf, 0x2b, 0xf6, 0x67, 0x1f, 0x1e, 0x08, 0xa1, 0x51, 0x6e, 0x2b, 0xc1, 0x11,
-	0xf0, 0x80, 0x89, 0x49, 0x4a, 0x34, 0x93, 0x62, 0x14, 0x11, 0x45, 0x5b, 0x7f, 0x3a, 0xa0, 0x6b,
-	0x07, 0x43, 0xc3, 0x78, 0x2f, 0xbd, 0xd3, 0x84, 0x69, 0x33, 0xcb, 0x58, 0x72, 0x3c, 0x96, 0x8a,
-	0x4b, 0xb5, 0xf9, 0xf5, 0x54, 0xfc, 0x88, 0xf5, 0x34, 0xa3, 0x0a, 0x5d, 0x08, 0xbd, 0x2e, 0xbd,
-	0xe3, 0xfa, 0xb5, 0x9f, 0x34, 0x3f, 0xfc, 0xbf, 0x13, 0x02, 0x13, 0x3f, 0xc1, 0xc6, 0x35, 0xc9,
-	0x09, 0x57, 0xce, 0x09, 0x84, 0x66, 0x41, 0xa3, 0x98, 0x0a, 0xc9, 0xab, 0xa9, 0xec, 0xd0, 0x36,
-	0xca, 0xc0, 0x08, 0xce, 0x25, 0xb4, 0x77, 0x95, 0x9b, 0x9e, 0xd0, 0x2f, 0x7a, 0x1a, 0xd0, 0x71,
-	0xf8, 0x0d, 0xe8, 0xff, 0x7d, 0x7e, 0xf1, 0xac, 0x60, 0x38, 0x5f, 0xba, 0x60, 0xb1, 0x74, 0xc1,
-	0xe7, 0xd2, 0x05, 0xb3, 0x95, 0x6b, 0x2d, 0x56, 0xae, 0xf5, 0xb6, 0x72, 0xad, 0xbb, 0xde, 0x1e,
-	0xd2, 0xd8, 0x27, 0xa8, 0xc6, 0x1b, 0x1b, 0x31, 0x97, 0x71, 0x91, 0x52, 0x55, 0x59, 0x5c, 0xd3,
-	0xa3, 0x46, 0xb5, 0xf5, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x4e, 0xb8, 0x09, 0xfc,
-	0x01, 0x00, 0x00,
+	// 1240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x8f, 0xd3, 0xc6,
+	0x17, 0x8f, 0x37, 0xd9, 0x7c, 0xd9, 0x49, 0xb2, 0xc0, 0xb0, 0xec, 0x9a, 0x7c, 0x21, 0x0e, 0x46,
+	0x6d, 0xa3, 0x4a, 0x38, 0x85, 0x1e, 0x5a, 0x71, 0xc3, 0x64, 0x97, 0xae, 0x54, 0x60, 0x35, 0x50,
+	0xf5, 0x97, 0x90, 0x35, 0xb1, 0x27, 0x89, 0x85, 0xed, 0xb1, 0x3c, 0x13, 0x94, 0x5c, 0x7b, 0x6a,
+	0x39, 0x71, 0xe4, 0x82, 0x54, 0x89, 0x1b, 0x7f, 0x09, 0x47, 0x6e, 0xad, 0x7a, 0x08, 0x15, 0xf4,
+	0xd6, 0x5b, 0xd4, 0x3f, 0xa0, 0x9a, 0x19, 0x27, 0xb1, 0xc3, 0x6e, 0x61, 0x59, 0xb8, 0xec, 0x7a,
+	0xde, 0x7b, 0xf3, 0xf9, 0xcc, 0xcc, 0x67, 0xe6, 0xbd, 0x17, 0x70, 0x3c, 0xf4, 0x23, 0xde, 0x16,
+	0x7f, 0xac, 0x38, 0xa1, 0x9c, 0xc2, 0xaa, 0x9f, 0xf8, 0x6c, 0x30, 0xec, 0x5a, 0xc2, 0x56, 0xdf,
+	0xe8, 0xd3, 0x3e, 0x95, 0x8e, 0xb6, 0xf8, 0x52, 0x31, 0x75, 0xa3, 0x4f, 0x69, 0x3f, 0x20, 0x6d,
+	0x39, 0xea, 0x0e, 0x7b, 0x6d, 0xee, 0x87, 0x84, 0x71, 0x1c, 0xc6, 0x69, 0x40, 0x63, 0x39, 0xc0,
+	0x1b, 0x26, 0x98, 0xfb, 0x34, 0x9a, 0xf9, 0x5d, 0xca, 0x42, 0xca, 0xda, 0x5d, 0xcc, 0x48, 0xfb,
+	0xfe, 0xa5, 0x2e, 0xe1, 0xf8, 0x52, 0xdb, 0xa5, 0x7e, 0xea, 0x37, 0x9f, 0xac, 0x80, 0xf2, 0x0d,
+	0x3f, 0xe2, 0x24, 0x81, 0x3f, 0x82, 0x4a, 0x80, 0x19, 0x77, 0x86, 0xb1, 0x87, 0x39, 0xd1, 0xb5,
+	0xa6, 0xd6, 0xaa, 0x5c, 0xae, 0x5b, 0x8a, 0xc0, 0x9a, 0x11, 0x58, 0x77, 0x66, 0x2b, 0xb0, 0x1b,
+	0xcf, 0x26, 0x46, 0x61, 0x3a, 0x31, 0xe0, 0x18, 0x87, 0xc1, 0x15, 0x33, 0x33, 0xd9, 0x7c, 0xf8,
+	0xc2, 0xd0, 0x10, 0x10, 0x96, 0x6f, 0xa4, 0x01, 0x46, 0x60, 0xdd, 0x8f, 0x7a, 0x81, 0x5c, 0x9a,
+	0x23, 0x16, 0xa3, 0xaf, 0x34, 0xb5, 0xd6, 0x9a, 0x7d, 0x5d, 0x60, 0xfc, 0x31, 0x31, 0x3e, 0xee,
+	0xfb, 0x5c, 0x9c, 0x85, 0x4b, 0xc3, 0x76, 0xba, 0x64, 0xf5, 0xef, 0x22, 0xf3, 0xee, 0xb5, 0xf9,
+	0x38, 0x26, 0xcc, 0xda, 0x8d, 0xf8, 0x74, 0x62, 0x9c, 0x56, 0x6c, 0x79, 0x34, 0x13, 0xd5, 0xe6,
+	0x06, 0x1b, 0x33, 0x02, 0xbf, 0x06, 0x6b, 0x73, 0x83, 0x5e, 0x94, 0x54, 0xd6, 0x21, 0xa8, 0x3a,
+	0xc4, 0x45, 0x0b, 0x00, 0xf3, 0x6f, 0x00, 0xca, 0x7b, 0x38, 0xc1, 0x21, 0x83, 0xe7, 0x00, 0x10,
+	0x7a, 0x39, 0x1e, 0x89, 0x68, 0x28, 0x0f, 0x69, 0x0d, 0xad, 0x09, 0x4b, 0x47, 0x18, 0xf2, 0xbc,
+	0x2b, 0x47, 0xe4, 0x85, 0x03, 0x70, 0x22, 0xc4, 0x23, 0x87, 0x04, 0x38, 0x66, 0xc4, 0x73, 0x84,
+	0xf8, 0x72, 0x33, 0x95, 0xcb, 0x67, 0x5e, 0xd3, 0xa5, 0x93, 0x0a, 0x6f, 0x5f, 0x48, 0x65, 0xd9,
+	0x52, 0x07, 0xb5, 0x0c, 0x60, 0x3e, 0x12, 0xda, 0xac, 0x87, 0x78, 0xb4, 0xad, 0xac, 0x42, 0x50,
+	0x68, 0x83, 0xe3, 0xdd, 0x80, 0xba, 0xf7, 0x98, 0x13, 0x93, 0xc4, 0x19, 0x13, 0x9c, 0xe8, 0xa5,
+	0xa6, 0xd6, 0x2a, 0xd9, 0xf5, 0xe9, 0xc4, 0xd8, 0x54, 0x48, 0x4b, 0x01, 0x26, 0xaa, 0x29, 0xcb,
+	0x1e, 0x49, 0xbe, 0x27, 0x38, 0x81, 0x21, 0x80, 0x0b, 0x55, 0x98, 0x3b, 0x20, 0xde, 0x30, 0x20,
+	0xfa, 0x6a, 0xb3, 0xd8, 0xaa, 0x5c, 0xfe, 0xbf, 0x95, 0xbd, 0xed, 0xd6, 0xee, 0x2c, 0xee, 0x36,
+	0x27, 0xb1, 0x7d, 0x3e, 0x5d, 0xf1, 0x99, 0x65, 0x69, 0x67, 0x20, 0x26, 0x3a, 0x39, 0x37, 0xde,
+	0x4e, 0x6d, 0xf0, 0x6e, 0xf6, 0x4a, 0x85, 0xd4, 0x23, 0x7a, 0xb9, 0xa9, 0xb5, 0xd6, 0x0f, 0xa4,
+	0xba, 0x41, 0x3d, 0x62, 0x9f, 0xd9, 0xef, 0x06, 0x89, 0xc9, 0xd9, 0x1b, 0x24, 0x22, 0xe1, 0x4f,
+	0x1a, 0x38, 0xbd, 0x08, 0x49, 0x30, 0x27, 0x8e, 0x3b, 0xc0, 0x51, 0x9f, 0xe8, 0xff, 0x93, 0xb2,
+	0xde, 0x3c, 0x9c, 0xac, 0xd3, 0x89, 0x71, 0x76, 0x99, 0x37, 0x03, 0x6a, 0xa2, 0x53, 0x73, 0x3b,
+	0xc2, 0x9c, 0x5c, 0x93, 0x56, 0x78, 0x0f, 0xd4, 0x32, 0xcb, 0xc4, 0x23, 0xfd, 0x98, 0xe4, 0xde,
+	0x39, 0x34, 0xf7, 0xc6, 0x6b, 0x7b, 0xc6, 0x23, 0x13, 0x55, 0x17, 0x5b, 0xc6, 0xa3, 0x25, 0x32,
+	0x3f, 0xd2, 0xd7, 0xde, 0x1b, 0x99, 0x1f, 0xe5, 0xc8, 0xfc, 0x08, 0x12, 0x50, 0xe9, 0x53, 0x1c,
+	0x38, 0x5d, 0x1a, 0x79, 0xc4, 0xd3, 0x81, 0xa4, 0xea, 0x1c, 0x9a, 0x2a, 0xcd, 0x3d, 0x19, 0x28,
+	0x13, 0x01, 0x31, 0xb2, 0xe5, 0x00, 0xfe, 0xa2, 0x01, 0xdd, 0xf3, 0x19, 0x4f, 0xfc, 0xee, 0x50,
+	0x2e, 0x25, 0x4e, 0x68, 0x4c, 0x13, 0xf1, 0xc9, 0xf4, 0x8a, 0x7c, 0x4a, 0x1f, 0xe5, 0xef, 0x4b,
+	0x27, 0x13, 0xbd, 0xb7, 0x08, 0xb6, 0x3f, 0x49, 0x2f, 0xa9, 0xa1, 0x18, 0x0f, 0x02, 0x35, 0xd1,
+	0x96, 0xb7, 0x3f, 0x02, 0xfc, 0x16, 0x6c, 0x7a, 0xe4, 0x3e, 0x09, 0xa8, 0x78, 0x41, 0xbd, 0x61,
+	0xe4, 0x39, 0xd8, 0xf3, 0x12, 0xc2, 0x98, 0x5e, 0x95, 0xbb, 0x3f, 0x3f, 0x9d, 0x18, 0xe7, 0x52,
+	0xf4, 0x7d, 0xe3, 0x4c, 0xb4, 0x31, 0x77, 0xec, 0x0c, 0x23, 0xef, 0xaa, 0x32, 0xc3, 0xbb, 0x40,
+	0x1f, 0xf8, 0x8c, 0xd3, 0x64, 0xec, 0x24, 0x84, 0x93, 0x48, 0xa5, 0x45, 0xf9, 0x36, 0xf5, 0x9a,
+	0x7c, 0xc5, 0x17, 0x16, 0x0b, 0x3f, 0x28, 0xd2, 0x44, 0x9b, 0xa9, 0x0b, 0xcd, 0x3c, 0xb6, 0x74,
+	0x88, 0x2c, 0x24, 0xb3, 0x9d, 0x33, 0x17, 0x90, 0xe9, 0xeb, 0xf2, 0x55, 0x9f, 0x5d, 0x3a, 0x3a,
+	0x11, 0x35, 0x7f, 0x6f, 0xb6, 0x91, 0x4f, 0x44, 0xcb, 0x18, 0x26, 0x3a, 0xee, 0xe5, 0x26, 0xb0,
+	0x2b, 0xa5, 0x47, 0xbf, 0x1a, 0x05, 0xf3, 0x2f, 0x0d, 0xac, 0xe7, 0xa1, 0xe0, 0x06, 0x58, 0xcd,
+	0x26, 0xdc, 0x55, 0xef, 0x03, 0x24, 0xdb, 0xd7, 0x4b, 0x54, 0xf1, 0x43, 0x96, 0x28, 0xf3, 0x69,
+	0x11, 0x6c, 0x1d, 0x70, 0xd9, 0xc4, 0x53, 0xec, 0x11, 0xe2, 0xb8, 0x34, 0x08, 0x88, 0xcb, 0x69,
+	0xa2, 0x6b, 0x47, 0x7b, 0x8a, 0x39, 0x30, 0x13, 0x55, 0x7b, 0x84, 0x5c, 0x9b, 0x0d, 0xc5, 0xc6,
+	0x5d, 0x1a, 0x86, 0xc3, 0xc8, 0xe7, 0x63, 0x27, 0xa6, 0x34, 0x78, 0x87, 0xda, 0xac, 0xd8, 0xd2,
+	0x8d, 0xe7, 0xd1, 0x4c, 0x54, 0x9b, 0x1b, 0xf6, 0x28, 0x0d, 0x04, 0x5f, 0xfe, 0x7e, 0xeb, 0xc5,
+	0xa3, 0xf1, 0xe5, 0xd1, 0x4c, 0x54, 0xcb, 0xbd, 0x12, 0x68, 0x83, 0x52, 0x77, 0x98, 0x44, 0x7a,
+	0xe9, 0x9d, 0x6e, 0x88, 0x9c, 0x6b, 0xfe, 0x53, 0x04, 0xb5, 0x5c, 0xd1, 0x82, 0x57, 0x40, 0x95,
+	0x71, 0x9c, 0x70, 0x67, 0x40, 0xfc, 0xfe, 0x80, 0x4b, 0x85, 0x8a, 0xf6, 0xd6, 0x74, 0x62, 0x9c,
+	0x52, 0xab, 0xca, 0x7a, 0x4d, 0x54, 0x91, 0xc3, 0xaf, 0xe4, 0x08, 0xde, 0x01, 0x40, 0x79, 0x65,
+	0x45, 0x5f, 0x79, 0x63, 0xa7, 0x25, 0xaa, 0xd6, 0xc9, 0x2c, 0xaa, 0x2c, 0xe4, 0xb2, 0xc9, 0x5a,
+	0x93, 0x06, 0x59, 0xc3, 0xdf, 0x6b, 0xcf, 0x03, 0x07, 0xa0, 0xea, 0x11, 0x17, 0x8f, 0x9d, 0x1e,
+	0x96, 0x37, 0x50, 0x9d, 0xde, 0xf6, 0xa1, 0x35, 0x3a, 0x35, 0xd3, 0x68, 0x81, 0x65, 0xa2, 0x8a,
+	0x1c, 0xee, 0xc8, 0x91, 0x38, 0x49, 0xe5, 0x4d, 0x53, 0xd6, 0xea, 0xf2, 0x49, 0x66, 0xbd, 0xb3,
+	0xb9, 0x69, 0x6e, 0xba, 0x3b, 0x9b, 0x1b, 0x93, 0xc4, 0xa7, 0x9e, 0x5e, 0x7e, 0x53, 0x77, 0x34,
+	0x4b, 0x4a, 0x39, 0x68, 0x35, 0x59, 0x75, 0x46, 0x0a, 0x7e, 0x4f, 0x59, 0x7e, 0x5b, 0x01, 0x40,
+	0xb4, 0xc7, 0x88, 0xb8, 0x34, 0xf1, 0xe0, 0x26, 0x28, 0x67, 0xd5, 0x46, 0xe9, 0x08, 0x7e, 0x09,
+	0x4a, 0x6f, 0xa9, 0xe4, 0x31, 0x41, 0x2f, 0x85, 0x93, 0x33, 0xe0, 0x17, 0xa0, 0x8c, 0x43, 0x3a,
+	0x8c, 0xf8, 0xbc, 0xaf, 0x53, 0xc7, 0x68, 0x89, 0x94, 0x61, 0xa5, 0x0d, 0xbb, 0x75, 0x8d, 0xfa,
+	0x91, 0x5d, 0x12, 0x53, 0x51, 0x1a, 0x9e, 0x17, 0xbb, 0x74, 0x54, 0xb1, 0x63, 0x50, 0x53, 0xe9,
+	0x59, 0xa1, 0xb3, 0xb4, 0x6b, 0xfb, 0x8f, 0xd5, 0x7c, 0x26, 0xc8, 0x9e, 0xbe, 0x30, 0x5a, 0x6f,
+	0x41, 0x26, 0x26, 0x30, 0x54, 0x95, 0x0c, 0x57, 0x15, 0xc1, 0xa7, 0x83, 0xcc, 0x7b, 0x92, 0xfd,
+	0xd6, 0x79, 0xb0, 0xba, 0xb3, 0xfb, 0xdd, 0x76, 0xe7, 0x44, 0xa1, 0xbe, 0xf9, 0xe0, 0x71, 0x13,
+	0xe6, 0xbc, 0x3b, 0xfe, 0x88, 0x78, 0xd0, 0x02, 0x55, 0xfb, 0xd6, 0xcd, 0xce, 0x76, 0xc7, 0x41,
+	0x57, 0xef, 0xec, 0xde, 0x3a, 0xa1, 0xd5, 0xcf, 0x3e, 0x78, 0xdc, 0xd4, 0x73, 0x91, 0xaa, 0xee,
+	0x23, 0x31, 0xae, 0x97, 0x7e, 0x7e, 0xd2, 0x28, 0xd8, 0xd7, 0x9f, 0xbd, 0x6c, 0x68, 0xcf, 0x5f,
+	0x36, 0xb4, 0x3f, 0x5f, 0x36, 0xb4, 0x87, 0xaf, 0x1a, 0x85, 0xe7, 0xaf, 0x1a, 0x85, 0xdf, 0x5f,
+	0x35, 0x0a, 0x3f, 0x5c, 0xcc, 0xac, 0x5d, 0x14, 0xb2, 0x88, 0xf0, 0x76, 0x5a, 0xd0, 0xda, 0x21,
+	0x15, 0x4d, 0x26, 0x93, 0x3f, 0xd8, 0xd4, 0x36, 0xba, 0x65, 0xa9, 0xe7, 0xe7, 0xff, 0x0e, 0x00,
+	0xb5, 0x5d, 0xb0, 0x64, 0xca, 0x0d, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -177,6 +597,16 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.InflationBase.Size()
 		i -= size
@@ -218,6 +648,114 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomInflations) > 0 {
+		for iNdEx := len(m.DenomInflations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomInflations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.HistoryRetentionBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HistoryRetentionBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.DeveloperFundAddress) > 0 {
+		i -= len(m.DeveloperFundAddress)
+		copy(dAtA[i:], m.DeveloperFundAddress)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.DeveloperFundAddress)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.InflationMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.InflationSchedule) > 0 {
+		for iNdEx := len(m.InflationSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x20
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxElapsedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxElapsedTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMint(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.Inflation.Size()
 		i -= size
@@ -238,52 +776,1390 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DenomInflation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *DenomInflation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomInflation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InflationBase.Size()
+		i -= size
+		if _, err := m.InflationBase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DeveloperFund.Size()
+		i -= size
+		if _, err := m.DeveloperFund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.FeeCollector.Size()
+		i -= size
+		if _, err := m.FeeCollector.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DecayPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DecayPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMint(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	if m.DecayBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.DecayBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMint(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomAmounts) > 0 {
+		for iNdEx := len(m.DenomAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintMint(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdate)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationBase.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxElapsedTime)
+	n += 1 + l + sovMint(uint64(l))
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	if len(m.InflationSchedule) > 0 {
+		for _, e := range m.InflationSchedule {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.InflationMode != 0 {
+		n += 1 + sovMint(uint64(m.InflationMode))
+	}
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = len(m.DeveloperFundAddress)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.HistoryRetentionBlocks != 0 {
+		n += 1 + sovMint(uint64(m.HistoryRetentionBlocks))
+	}
+	if len(m.DenomInflations) > 0 {
+		for _, e := range m.DenomInflations {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomInflation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationBase.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeCollector.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DeveloperFund.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *InflationStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.DecayBlocks != 0 {
+		n += 1 + sovMint(uint64(m.DecayBlocks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DecayPeriod)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *MintRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.DenomAmounts) > 0 {
+		for _, e := range m.DenomAmounts {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxElapsedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxElapsedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSchedule = append(m.InflationSchedule, InflationStep{})
+			if err := m.InflationSchedule[len(m.InflationSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMode", wireType)
+			}
+			m.InflationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationMode |= InflationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperFundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetentionBlocks", wireType)
+			}
+			m.HistoryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomInflations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomInflations = append(m.DenomInflations, DenomInflation{})
+			if err := m.DenomInflations[len(m.DenomInflations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomInflation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomInflation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomInflation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCollector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdate)
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationBase.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
-
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *InflationStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -306,15 +2182,34 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: InflationStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InflationStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -341,13 +2236,16 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdate, dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -375,7 +2273,93 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayBlocks", wireType)
+			}
+			m.DecayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DecayPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -400,7 +2384,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *MintRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -423,17 +2407,36 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -443,25 +2446,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
 			}
@@ -495,6 +2532,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomAmounts = append(m.DenomAmounts, types.Coin{})
+			if err := m.DenomAmounts[len(m.DenomAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
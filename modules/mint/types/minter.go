@@ -9,7 +9,7 @@ import (
 )
 
 const (
-	blocksPerYear = 60 * 60 * 8766 / 5 // 5 second a block, 8766 = 365.25 * 24
+	year = 8766 * time.Hour // 8766 = 365.25 * 24
 )
 
 var initialIssue = sdkmath.NewIntWithDecimal(20, 8)
@@ -19,15 +19,18 @@ func NewMinter(lastUpdate time.Time, inflationBase sdk.Int) Minter {
 	return Minter{
 		LastUpdate:    lastUpdate,
 		InflationBase: inflationBase,
+		Inflation:     sdk.ZeroDec(),
 	}
 }
 
 // DefaultMinter returns minter object for a new chain
 func DefaultMinter() Minter {
-	return NewMinter(
+	minter := NewMinter(
 		time.Unix(0, 0).UTC(),
 		initialIssue.Mul(sdkmath.NewIntWithDecimal(1, 6)), // 20*(10^8)iris, 20*(10^8)*(10^6)uiris
 	)
+	minter.Inflation = DefaultParams().Inflation
+	return minter
 }
 
 // ValidateMinter returns err if the Minter is invalid
@@ -38,6 +41,9 @@ func ValidateMinter(m Minter) error {
 	if !m.InflationBase.GT(sdk.ZeroInt()) {
 		return fmt.Errorf("minter inflation basement (%s) should be positive", m.InflationBase.String())
 	}
+	if m.Inflation.IsNil() || m.Inflation.IsNegative() || m.Inflation.GT(sdk.OneDec()) {
+		return fmt.Errorf("minter inflation (%s) should be between [0, 1]", m.Inflation)
+	}
 	return nil
 }
 
@@ -46,9 +52,68 @@ func (m Minter) NextAnnualProvisions(params Params) (provisions sdk.Dec) {
 	return params.Inflation.MulInt(m.InflationBase)
 }
 
-// BlockProvision gets the provisions for a block based on the annual provisions rate
+// BlockProvision estimates the provisions for a block based on the annual provisions rate and
+// the expected blocks per year. It is not used to mint, as the provisions minted in a block
+// follow the time elapsed since the last update, see ElapsedProvision.
 func (m Minter) BlockProvision(params Params) sdk.Coin {
 	provisions := m.NextAnnualProvisions(params)
-	blockInflationAmount := provisions.QuoInt(sdk.NewInt(blocksPerYear))
+	blockInflationAmount := provisions.QuoInt(sdk.NewIntFromUint64(params.BlocksPerYear))
 	return sdk.NewCoin(params.MintDenom, blockInflationAmount.TruncateInt())
 }
+
+// ElapsedProvision gets the provisions for the time elapsed from the last update to the
+// given block time based on the annual provisions rate. The elapsed time is capped by
+// the max elapsed time of params, so that a chain resuming from a halt does not mint
+// the provisions of the whole halt at once.
+func (m Minter) ElapsedProvision(params Params, blockTime time.Time) sdk.Coin {
+	return m.elapsedProvision(params, params.MintDenom, m.NextAnnualProvisions(params), blockTime)
+}
+
+// ElapsedDenomProvision gets the provisions of an additional token for the time elapsed from
+// the last update to the given block time, capped in the same way as ElapsedProvision
+func (m Minter) ElapsedDenomProvision(params Params, denomInflation DenomInflation, blockTime time.Time) sdk.Coin {
+	return m.elapsedProvision(params, denomInflation.Denom, denomInflation.AnnualProvisions(), blockTime)
+}
+
+func (m Minter) elapsedProvision(params Params, denom string, annualProvisions sdk.Dec, blockTime time.Time) sdk.Coin {
+	elapsed := m.elapsedTime(params, blockTime)
+	if elapsed == 0 {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	elapsedInflationAmount := annualProvisions.MulInt64(int64(elapsed)).QuoInt64(int64(year))
+	return sdk.NewCoin(denom, elapsedInflationAmount.TruncateInt())
+}
+
+// NextInflationRate gets the inflation rate of the bonded ratio mode for the given block time.
+// The rate moves toward the max inflation while the bonded ratio is below the goal and toward
+// the min inflation while it is above, by at most the inflation rate change per year.
+func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec, blockTime time.Time) sdk.Dec {
+	// (1 - bondedRatio/goalBonded) * inflationRateChange
+	inflationRateChangePerYear := sdk.OneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange)
+	elapsed := m.elapsedTime(params, blockTime)
+	inflationRateChange := inflationRateChangePerYear.MulInt64(int64(elapsed)).QuoInt64(int64(year))
+
+	inflation := m.Inflation.Add(inflationRateChange)
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+	return inflation
+}
+
+// elapsedTime returns the time elapsed from the last update to the given block time,
+// capped by the max elapsed time of params
+func (m Minter) elapsedTime(params Params, blockTime time.Time) time.Duration {
+	elapsed := blockTime.Sub(m.LastUpdate)
+	if elapsed <= 0 {
+		return 0
+	}
+	if elapsed > params.MaxElapsedTime {
+		return params.MaxElapsedTime
+	}
+	return elapsed
+}
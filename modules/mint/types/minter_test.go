@@ -13,9 +13,9 @@ import (
 func TestNextInflation(t *testing.T) {
 	minter := NewMinter(time.Now(), sdkmath.NewIntWithDecimal(100, 18))
 	tests := []struct{ params Params }{
-		{Params{Inflation: sdk.NewDecWithPrec(20, 2), MintDenom: sdk.DefaultBondDenom}},
-		{Params{Inflation: sdk.NewDecWithPrec(10, 2), MintDenom: sdk.DefaultBondDenom}},
-		{Params{Inflation: sdk.NewDecWithPrec(5, 2), MintDenom: sdk.DefaultBondDenom}},
+		{Params{Inflation: sdk.NewDecWithPrec(20, 2), MintDenom: sdk.DefaultBondDenom, BlocksPerYear: DefaultBlocksPerYear}},
+		{Params{Inflation: sdk.NewDecWithPrec(10, 2), MintDenom: sdk.DefaultBondDenom, BlocksPerYear: DefaultBlocksPerYear}},
+		{Params{Inflation: sdk.NewDecWithPrec(5, 2), MintDenom: sdk.DefaultBondDenom, BlocksPerYear: DefaultBlocksPerYear}},
 	}
 	for _, tc := range tests {
 		annualProvisions := minter.NextAnnualProvisions(tc.params)
@@ -25,6 +25,92 @@ func TestNextInflation(t *testing.T) {
 	}
 }
 
+func TestBlockProvisionBlocksPerYear(t *testing.T) {
+	minter := NewMinter(time.Now(), sdkmath.NewIntWithDecimal(100, 18))
+	params := DefaultParams()
+	annualProvisions := minter.NextAnnualProvisions(params)
+
+	// a chain with 6 second blocks
+	params.BlocksPerYear = 60 * 60 * 8766 / 6
+	require.NoError(t, params.Validate())
+	require.Equal(t, annualProvisions.QuoInt64(10*60*8766).TruncateInt(), minter.BlockProvision(params).Amount)
+
+	params.BlocksPerYear = 0
+	require.ErrorIs(t, params.Validate(), ErrInvalidBlocksPerYear)
+}
+
+func TestElapsedProvision(t *testing.T) {
+	lastUpdate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	minter := NewMinter(lastUpdate, sdkmath.NewIntWithDecimal(100, 18))
+	params := NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), time.Minute, DefaultBlocksPerYear)
+	blockProvision := minter.BlockProvision(params).Amount
+
+	tests := []struct {
+		name     string
+		elapsed  time.Duration
+		expected sdk.Int
+	}{
+		{"no time elapsed", 0, sdk.ZeroInt()},
+		{"clock drifting backwards", -5 * time.Second, sdk.ZeroInt()},
+		{"5 second block", 5 * time.Second, blockProvision},
+		{"1 second block", time.Second, blockProvision.QuoRaw(5)},
+		{"10 second block", 10 * time.Second, blockProvision.MulRaw(2)},
+		{"halted for a day", 24 * time.Hour, blockProvision.MulRaw(12)},
+	}
+	for _, tc := range tests {
+		coin := minter.ElapsedProvision(params, lastUpdate.Add(tc.elapsed))
+		require.Equal(t, sdk.DefaultBondDenom, coin.Denom, tc.name)
+		// allow the rounding of the block provision
+		require.True(t, coin.Amount.Sub(tc.expected).Abs().LTE(sdk.NewInt(12)), "%s: %s != %s", tc.name, coin.Amount, tc.expected)
+	}
+}
+
+func TestNextInflationRate(t *testing.T) {
+	lastUpdate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	minter := NewMinter(lastUpdate, sdkmath.NewIntWithDecimal(100, 18))
+	minter.Inflation = sdk.NewDecWithPrec(10, 2)
+	params := DefaultParams()
+	params.InflationMode = InflationModeBondedRatio
+	params.MaxElapsedTime = year
+
+	tests := []struct {
+		name        string
+		bondedRatio sdk.Dec
+		elapsed     time.Duration
+		expected    sdk.Dec
+	}{
+		{"at the goal", DefaultGoalBonded, year, sdk.NewDecWithPrec(10, 2)},
+		{"no time elapsed", sdk.ZeroDec(), 0, sdk.NewDecWithPrec(10, 2)},
+		// (1 - 0.335/0.67) * 0.13 for half a year
+		{"below the goal", sdk.NewDecWithPrec(335, 3), year / 2, sdk.NewDecWithPrec(1325, 4)},
+		// (1 - 0.8375/0.67) * 0.13 for half a year
+		{"above the goal", sdk.NewDecWithPrec(8375, 4), year / 2, sdk.NewDecWithPrec(8375, 5)},
+		{"capped by the max", sdk.ZeroDec(), year, DefaultInflationMax},
+		{"capped by the min", sdk.OneDec(), year, DefaultInflationMin},
+	}
+	for _, tc := range tests {
+		inflation := minter.NextInflationRate(params, tc.bondedRatio, lastUpdate.Add(tc.elapsed))
+		require.Equal(t, tc.expected, inflation, tc.name)
+	}
+}
+
+func TestInflationModeParams(t *testing.T) {
+	params := DefaultParams()
+	params.InflationMode = InflationModeBondedRatio
+	require.NoError(t, params.Validate())
+
+	params.InflationMode = InflationMode(2)
+	require.ErrorIs(t, params.Validate(), ErrInvalidInflationMode)
+
+	params = DefaultParams()
+	params.InflationMin = params.InflationMax.Add(sdk.NewDecWithPrec(1, 2))
+	require.ErrorIs(t, params.Validate(), ErrInvalidInflationMode)
+
+	params = DefaultParams()
+	params.GoalBonded = sdk.ZeroDec()
+	require.ErrorIs(t, params.Validate(), ErrInvalidInflationMode)
+}
+
 func TestDefaultMinter(t *testing.T) {
 	err := ValidateMinter(DefaultMinter())
 	require.NoError(t, err)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgUpdateInflationBase = "update_inflation_base" // type for MsgUpdateInflationBase
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateInflationBase{}
+)
+
+// NewMsgUpdateInflationBase constructs a MsgUpdateInflationBase
+func NewMsgUpdateInflationBase(authority string, inflationBase sdk.Int, rebaseToSupply bool) *MsgUpdateInflationBase {
+	return &MsgUpdateInflationBase{
+		Authority:      authority,
+		InflationBase:  inflationBase,
+		RebaseToSupply: rebaseToSupply,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgUpdateInflationBase) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgUpdateInflationBase) Type() string { return TypeMsgUpdateInflationBase }
+
+// GetSignBytes implements Msg.
+func (msg MsgUpdateInflationBase) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgUpdateInflationBase) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if msg.RebaseToSupply {
+		if !msg.InflationBase.IsNil() && !msg.InflationBase.IsZero() {
+			return sdkerrors.Wrap(ErrInvalidMinter, "inflation base must be empty when rebasing to the supply")
+		}
+		return nil
+	}
+	if msg.InflationBase.IsNil() || !msg.InflationBase.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidMinter, "inflation base (%s) should be positive", msg.InflationBase)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgUpdateInflationBase) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var authority = sdk.AccAddress(crypto.AddressHash([]byte("authority"))).String()
+
+func TestMsgUpdateInflationBaseValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        *MsgUpdateInflationBase
+		expectPass bool
+	}{
+		{"inflation base", NewMsgUpdateInflationBase(authority, sdk.NewInt(100), false), true},
+		{"rebase to supply", NewMsgUpdateInflationBase(authority, sdk.ZeroInt(), true), true},
+		{"rebase to supply with empty inflation base", &MsgUpdateInflationBase{Authority: authority, RebaseToSupply: true}, true},
+		{"invalid authority", NewMsgUpdateInflationBase("invalid", sdk.NewInt(100), false), false},
+		{"zero inflation base", NewMsgUpdateInflationBase(authority, sdk.ZeroInt(), false), false},
+		{"empty inflation base", &MsgUpdateInflationBase{Authority: authority}, false},
+		{"rebase to supply with inflation base", NewMsgUpdateInflationBase(authority, sdk.NewInt(100), true), false},
+	}
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgUpdateInflationBaseGetSigners(t *testing.T) {
+	msg := NewMsgUpdateInflationBase(authority, sdk.NewInt(100), false)
+	require.Equal(t, TypeMsgUpdateInflationBase, msg.Type())
+	require.Equal(t, authority, msg.GetSigners()[0].String())
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -16,13 +17,46 @@ import (
 const (
 	DefaultParamSpace = "mint"
 	MintDenom         = sdk.DefaultBondDenom
+
+	// DefaultMaxElapsedTime is the default maximum elapsed time minted in a block
+	DefaultMaxElapsedTime = time.Minute
+	// DefaultBlocksPerYear is the default number of blocks per year, 5 second a block, 8766 = 365.25 * 24.
+	// It is only used to estimate the provision of a block, not to mint.
+	DefaultBlocksPerYear = uint64(60 * 60 * 8766 / 5)
+)
+
+// default params of the bonded ratio inflation mode
+var (
+	DefaultInflationRateChange = sdk.NewDecWithPrec(13, 2)
+	DefaultInflationMax        = sdk.NewDecWithPrec(20, 2)
+	DefaultInflationMin        = sdk.NewDecWithPrec(7, 2)
+	DefaultGoalBonded          = sdk.NewDecWithPrec(67, 2)
 )
 
-//Parameter store key
+// Parameter store key
 var (
 	// params store for inflation params
 	KeyInflation = []byte("Inflation")
 	KeyMintDenom = []byte("MintDenom")
+	// params store for the maximum elapsed time minted in a block
+	KeyMaxElapsedTime = []byte("MaxElapsedTime")
+	// params store for the expected number of blocks per year, only used by the block provision estimate
+	KeyBlocksPerYear = []byte("BlocksPerYear")
+	// params store for the scheduled inflation rates
+	KeyInflationSchedule = []byte("InflationSchedule")
+	// params store for the bonded ratio inflation mode
+	KeyInflationMode       = []byte("InflationMode")
+	KeyInflationRateChange = []byte("InflationRateChange")
+	KeyInflationMax        = []byte("InflationMax")
+	KeyInflationMin        = []byte("InflationMin")
+	KeyGoalBonded          = []byte("GoalBonded")
+	// params store for the distribution of the minted coins
+	KeyDistributionProportions = []byte("DistributionProportions")
+	KeyDeveloperFundAddress    = []byte("DeveloperFundAddress")
+	// params store for the number of blocks whose mint records are retained
+	KeyHistoryRetentionBlocks = []byte("HistoryRetentionBlocks")
+	// params store for the additional tokens minted at fixed inflation rates
+	KeyDenomInflations = []byte("DenomInflations")
 )
 
 // ParamTable for mint module
@@ -30,18 +64,37 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(mintDenom string, inflation sdk.Dec) Params {
+// NewParams returns params of the fixed inflation mode, the bonded ratio mode params are set to the defaults
+func NewParams(mintDenom string, inflation sdk.Dec, maxElapsedTime time.Duration, blocksPerYear uint64) Params {
 	return Params{
-		MintDenom: mintDenom,
-		Inflation: inflation,
+		MintDenom:           mintDenom,
+		Inflation:           inflation,
+		MaxElapsedTime:      maxElapsedTime,
+		BlocksPerYear:       blocksPerYear,
+		InflationMode:       InflationModeFixed,
+		InflationRateChange: DefaultInflationRateChange,
+		InflationMax:        DefaultInflationMax,
+		InflationMin:        DefaultInflationMin,
+		GoalBonded:          DefaultGoalBonded,
+
+		DistributionProportions: DefaultDistributionProportions(),
 	}
 }
 
 // DefaultParams returns default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		Inflation: sdk.NewDecWithPrec(4, 2),
-		MintDenom: MintDenom,
+		Inflation:           sdk.NewDecWithPrec(4, 2),
+		MintDenom:           MintDenom,
+		MaxElapsedTime:      DefaultMaxElapsedTime,
+		BlocksPerYear:       DefaultBlocksPerYear,
+		InflationMode:       InflationModeFixed,
+		InflationRateChange: DefaultInflationRateChange,
+		InflationMax:        DefaultInflationMax,
+		InflationMin:        DefaultInflationMin,
+		GoalBonded:          DefaultGoalBonded,
+
+		DistributionProportions: DefaultDistributionProportions(),
 	}
 }
 
@@ -56,6 +109,18 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyInflation, &p.Inflation, validateInflation),
 		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
+		paramtypes.NewParamSetPair(KeyMaxElapsedTime, &p.MaxElapsedTime, validateMaxElapsedTime),
+		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyInflationSchedule, &p.InflationSchedule, validateInflationSchedule),
+		paramtypes.NewParamSetPair(KeyInflationMode, &p.InflationMode, validateInflationMode),
+		paramtypes.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateInflationRateChange),
+		paramtypes.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateInflationBound),
+		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationBound),
+		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
+		paramtypes.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
+		paramtypes.NewParamSetPair(KeyDeveloperFundAddress, &p.DeveloperFundAddress, validateDeveloperFundAddress),
+		paramtypes.NewParamSetPair(KeyHistoryRetentionBlocks, &p.HistoryRetentionBlocks, validateHistoryRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyDenomInflations, &p.DenomInflations, validateDenomInflations),
 	}
 }
 
@@ -72,6 +137,50 @@ func (p Params) Validate() error {
 	if len(p.MintDenom) == 0 {
 		return sdkerrors.Wrapf(ErrInvalidMintDenom, "Mint denom [%s] should not be empty", p.MintDenom)
 	}
+	if err := validateMaxElapsedTime(p.MaxElapsedTime); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMaxElapsedTime, err.Error())
+	}
+	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
+		return sdkerrors.Wrap(ErrInvalidBlocksPerYear, err.Error())
+	}
+	if err := validateInflationSchedule(p.InflationSchedule); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInflationSchedule, err.Error())
+	}
+	if err := validateInflationMode(p.InflationMode); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInflationMode, err.Error())
+	}
+	if err := validateInflationRateChange(p.InflationRateChange); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInflationMode, err.Error())
+	}
+	if err := validateInflationBound(p.InflationMax); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInflationMode, err.Error())
+	}
+	if err := validateInflationBound(p.InflationMin); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInflationMode, err.Error())
+	}
+	if p.InflationMax.LT(p.InflationMin) {
+		return sdkerrors.Wrapf(ErrInvalidInflationMode, "max inflation [%s] must be greater than or equal to min inflation [%s]", p.InflationMax, p.InflationMin)
+	}
+	if err := validateGoalBonded(p.GoalBonded); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInflationMode, err.Error())
+	}
+	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDistributionProportions, err.Error())
+	}
+	if err := validateDeveloperFundAddress(p.DeveloperFundAddress); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDistributionProportions, err.Error())
+	}
+	if p.DistributionProportions.DeveloperFund.IsPositive() && len(p.DeveloperFundAddress) == 0 {
+		return sdkerrors.Wrap(ErrInvalidDistributionProportions, "developer fund address should not be empty with a positive developer fund proportion")
+	}
+	if err := validateDenomInflations(p.DenomInflations); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenomInflation, err.Error())
+	}
+	for _, d := range p.DenomInflations {
+		if d.Denom == p.MintDenom {
+			return sdkerrors.Wrapf(ErrInvalidDenomInflation, "denom inflation of the mint denom %s", d.Denom)
+		}
+	}
 	return nil
 }
 
@@ -103,3 +212,114 @@ func validateMintDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxElapsedTime(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max elapsed time [%s] should be positive", v)
+	}
+
+	return nil
+}
+
+func validateBlocksPerYear(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("blocks per year must be positive")
+	}
+
+	return nil
+}
+
+func validateInflationMode(i interface{}) error {
+	v, ok := i.(InflationMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationMode_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown inflation mode [%d]", v)
+	}
+
+	return nil
+}
+
+func validateInflationRateChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("inflation rate change [%s] should be between [0, 1]", v)
+	}
+
+	return nil
+}
+
+func validateInflationBound(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.NewDecWithPrec(2, 1)) {
+		return fmt.Errorf("inflation bound [%s] should be between [0, 0.2]", v)
+	}
+
+	return nil
+}
+
+func validateGoalBonded(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("goal bonded [%s] should be between (0, 1]", v)
+	}
+
+	return nil
+}
+
+func validateDistributionProportions(i interface{}) error {
+	v, ok := i.(DistributionProportions)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateDeveloperFundAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid developer fund address [%s]: %s", v, err)
+	}
+
+	return nil
+}
+
+func validateHistoryRetentionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHeightInflationStep constructs an inflation step taking effect from the given block height
+func NewHeightInflationStep(startHeight int64, inflation sdk.Dec) InflationStep {
+	return InflationStep{
+		StartHeight: startHeight,
+		Inflation:   inflation,
+		DecayFactor: sdk.ZeroDec(),
+	}
+}
+
+// NewTimeInflationStep constructs an inflation step taking effect from the given block time
+func NewTimeInflationStep(startTime time.Time, inflation sdk.Dec) InflationStep {
+	return InflationStep{
+		StartTime:   &startTime,
+		Inflation:   inflation,
+		DecayFactor: sdk.ZeroDec(),
+	}
+}
+
+// IsHeightStep returns true if the step takes effect from a block height
+func (s InflationStep) IsHeightStep() bool {
+	return s.StartTime == nil
+}
+
+// HasStarted returns true if the step has taken effect at the given block height and time
+func (s InflationStep) HasStarted(height int64, blockTime time.Time) bool {
+	if s.IsHeightStep() {
+		return height >= s.StartHeight
+	}
+	return !blockTime.Before(*s.StartTime)
+}
+
+// InflationAt returns the inflation rate of the step at the given block height and time,
+// which is decayed by the decay factor once per decay period elapsed since the start
+func (s InflationStep) InflationAt(height int64, blockTime time.Time) sdk.Dec {
+	if !s.decays() {
+		return s.Inflation
+	}
+
+	var periods int64
+	if s.IsHeightStep() {
+		periods = (height - s.StartHeight) / s.DecayBlocks
+	} else {
+		periods = int64(blockTime.Sub(*s.StartTime) / s.DecayPeriod)
+	}
+	if periods <= 0 {
+		return s.Inflation
+	}
+	return s.Inflation.Mul(s.DecayFactor.Power(uint64(periods)))
+}
+
+// Validate returns err if the step is invalid
+func (s InflationStep) Validate() error {
+	if s.StartHeight < 0 {
+		return fmt.Errorf("start height [%d] should not be negative", s.StartHeight)
+	}
+	if (s.StartHeight > 0) == (s.StartTime != nil) {
+		return fmt.Errorf("exactly one of start height and start time should be specified")
+	}
+	if s.Inflation.IsNil() {
+		return fmt.Errorf("inflation of the step should be specified")
+	}
+	if err := validateInflation(s.Inflation); err != nil {
+		return err
+	}
+	if s.DecayBlocks < 0 || s.DecayPeriod < 0 {
+		return fmt.Errorf("decay blocks [%d] and decay period [%s] should not be negative", s.DecayBlocks, s.DecayPeriod)
+	}
+	if !s.decays() {
+		if s.DecayBlocks != 0 || s.DecayPeriod != 0 {
+			return fmt.Errorf("decay factor should be specified with a decay interval")
+		}
+		return nil
+	}
+	if s.DecayFactor.IsNegative() || s.DecayFactor.GT(sdk.OneDec()) {
+		return fmt.Errorf("decay factor [%s] should be between (0, 1]", s.DecayFactor)
+	}
+	if s.IsHeightStep() && (s.DecayBlocks == 0 || s.DecayPeriod != 0) {
+		return fmt.Errorf("a step starting from a block height should decay by blocks")
+	}
+	if !s.IsHeightStep() && (s.DecayPeriod == 0 || s.DecayBlocks != 0) {
+		return fmt.Errorf("a step starting from a block time should decay by a period")
+	}
+	return nil
+}
+
+func (s InflationStep) decays() bool {
+	return !s.DecayFactor.IsNil() && !s.DecayFactor.IsZero()
+}
+
+// InflationAt returns the inflation rate at the given block height and time, which is the
+// rate of the latest scheduled step having taken effect, or the flat inflation rate if none
+func (p Params) InflationAt(height int64, blockTime time.Time) sdk.Dec {
+	inflation := p.Inflation
+	for _, step := range p.InflationSchedule {
+		if !step.HasStarted(height, blockTime) {
+			break
+		}
+		inflation = step.InflationAt(height, blockTime)
+	}
+	return inflation
+}
+
+// UpcomingSteps returns the scheduled steps which have not taken effect at the given block height and time
+func (p Params) UpcomingSteps(height int64, blockTime time.Time) []InflationStep {
+	for i, step := range p.InflationSchedule {
+		if !step.HasStarted(height, blockTime) {
+			return p.InflationSchedule[i:]
+		}
+	}
+	return []InflationStep{}
+}
+
+func validateInflationSchedule(i interface{}) error {
+	v, ok := i.([]InflationStep)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, step := range v {
+		if err := step.Validate(); err != nil {
+			return fmt.Errorf("invalid inflation step %d: %s", i, err)
+		}
+		if i == 0 {
+			continue
+		}
+
+		prev := v[i-1]
+		if step.IsHeightStep() != prev.IsHeightStep() {
+			return fmt.Errorf("inflation steps should either all start from block heights or all from block times")
+		}
+		if step.IsHeightStep() && step.StartHeight <= prev.StartHeight ||
+			!step.IsHeightStep() && !step.StartTime.After(*prev.StartTime) {
+			return fmt.Errorf("inflation step %d should start after the step %d", i, i-1)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestInflationAt(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	halving := NewHeightInflationStep(100, sdk.NewDecWithPrec(8, 2))
+	halving.DecayFactor = sdk.NewDecWithPrec(5, 1)
+	halving.DecayBlocks = 1000
+
+	params := DefaultParams()
+	params.InflationSchedule = []InflationStep{
+		NewHeightInflationStep(10, sdk.NewDecWithPrec(10, 2)),
+		halving,
+	}
+	require.NoError(t, params.Validate())
+
+	tests := []struct {
+		height    int64
+		inflation sdk.Dec
+		upcoming  int
+	}{
+		{1, params.Inflation, 2},
+		{10, sdk.NewDecWithPrec(10, 2), 1},
+		{100, sdk.NewDecWithPrec(8, 2), 0},
+		{1099, sdk.NewDecWithPrec(8, 2), 0},
+		{1100, sdk.NewDecWithPrec(4, 2), 0},
+		{3100, sdk.NewDecWithPrec(1, 2), 0},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.inflation, params.InflationAt(tc.height, start), "height %d", tc.height)
+		require.Len(t, params.UpcomingSteps(tc.height, start), tc.upcoming, "height %d", tc.height)
+	}
+
+	step := NewTimeInflationStep(start, sdk.NewDecWithPrec(8, 2))
+	step.DecayFactor = sdk.NewDecWithPrec(5, 1)
+	step.DecayPeriod = 24 * time.Hour
+	params.InflationSchedule = []InflationStep{step}
+	require.NoError(t, params.Validate())
+	require.Equal(t, params.Inflation, params.InflationAt(1, start.Add(-time.Second)))
+	require.Equal(t, sdk.NewDecWithPrec(8, 2), params.InflationAt(1, start.Add(time.Hour)))
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), params.InflationAt(1, start.Add(49*time.Hour)))
+}
+
+func TestValidateInflationSchedule(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	inflation := sdk.NewDecWithPrec(4, 2)
+	decaying := func(step InflationStep, blocks int64, period time.Duration) InflationStep {
+		step.DecayFactor = sdk.NewDecWithPrec(5, 1)
+		step.DecayBlocks = blocks
+		step.DecayPeriod = period
+		return step
+	}
+	both := NewHeightInflationStep(10, inflation)
+	both.StartTime = &start
+
+	tests := []struct {
+		name       string
+		expectPass bool
+		schedule   []InflationStep
+	}{
+		{"empty", true, nil},
+		{"heights", true, []InflationStep{NewHeightInflationStep(10, inflation), NewHeightInflationStep(20, inflation)}},
+		{"times", true, []InflationStep{NewTimeInflationStep(start, inflation), NewTimeInflationStep(start.Add(time.Hour), inflation)}},
+		{"halving by blocks", true, []InflationStep{decaying(NewHeightInflationStep(10, inflation), 100, 0)}},
+		{"halving by period", true, []InflationStep{decaying(NewTimeInflationStep(start, inflation), 0, time.Hour)}},
+		{"no start", false, []InflationStep{NewHeightInflationStep(0, inflation)}},
+		{"both starts", false, []InflationStep{both}},
+		{"inflation out of range", false, []InflationStep{NewHeightInflationStep(10, sdk.NewDecWithPrec(3, 1))}},
+		{"not increasing heights", false, []InflationStep{NewHeightInflationStep(20, inflation), NewHeightInflationStep(20, inflation)}},
+		{"not increasing times", false, []InflationStep{NewTimeInflationStep(start.Add(time.Hour), inflation), NewTimeInflationStep(start, inflation)}},
+		{"mixed starts", false, []InflationStep{NewHeightInflationStep(10, inflation), NewTimeInflationStep(start, inflation)}},
+		{"halving by period from height", false, []InflationStep{decaying(NewHeightInflationStep(10, inflation), 0, time.Hour)}},
+		{"halving by blocks from time", false, []InflationStep{decaying(NewTimeInflationStep(start, inflation), 100, 0)}},
+		{"decay interval without factor", false, []InflationStep{func() InflationStep {
+			step := NewHeightInflationStep(10, inflation)
+			step.DecayBlocks = 100
+			return step
+		}()}},
+	}
+	for _, tc := range tests {
+		params := DefaultParams()
+		params.InflationSchedule = tc.schedule
+		err := params.Validate()
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidInflationSchedule, tc.name)
+		}
+	}
+}
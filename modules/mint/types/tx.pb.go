@@ -0,0 +1,626 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mint/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateInflationBase defines the properties of the update inflation base message
+type MsgUpdateInflationBase struct {
+	// authority is the address of the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// new inflation base, must be empty if rebase_to_supply is set
+	InflationBase github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=inflation_base,json=inflationBase,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflation_base" yaml:"inflation_base"`
+	// whether to rebase the inflation base to the current supply of the mint denom
+	RebaseToSupply bool `protobuf:"varint,3,opt,name=rebase_to_supply,json=rebaseToSupply,proto3" json:"rebase_to_supply,omitempty" yaml:"rebase_to_supply"`
+}
+
+func (m *MsgUpdateInflationBase) Reset()         { *m = MsgUpdateInflationBase{} }
+func (m *MsgUpdateInflationBase) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInflationBase) ProtoMessage()    {}
+func (*MsgUpdateInflationBase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c467a85e368a1a7, []int{0}
+}
+func (m *MsgUpdateInflationBase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInflationBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInflationBase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInflationBase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInflationBase.Merge(m, src)
+}
+func (m *MsgUpdateInflationBase) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInflationBase) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInflationBase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInflationBase proto.InternalMessageInfo
+
+func (m *MsgUpdateInflationBase) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateInflationBase) GetRebaseToSupply() bool {
+	if m != nil {
+		return m.RebaseToSupply
+	}
+	return false
+}
+
+// MsgUpdateInflationBaseResponse defines the Msg/UpdateInflationBase response type
+type MsgUpdateInflationBaseResponse struct {
+}
+
+func (m *MsgUpdateInflationBaseResponse) Reset()         { *m = MsgUpdateInflationBaseResponse{} }
+func (m *MsgUpdateInflationBaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInflationBaseResponse) ProtoMessage()    {}
+func (*MsgUpdateInflationBaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c467a85e368a1a7, []int{1}
+}
+func (m *MsgUpdateInflationBaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInflationBaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInflationBaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInflationBaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInflationBaseResponse.Merge(m, src)
+}
+func (m *MsgUpdateInflationBaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInflationBaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInflationBaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInflationBaseResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateInflationBase)(nil), "irishub.mint.MsgUpdateInflationBase")
+	proto.RegisterType((*MsgUpdateInflationBaseResponse)(nil), "irishub.mint.MsgUpdateInflationBaseResponse")
+}
+
+func init() { proto.RegisterFile("mint/tx.proto", fileDescriptor_6c467a85e368a1a7) }
+
+var fileDescriptor_6c467a85e368a1a7 = []byte{
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbf, 0x6a, 0xeb, 0x30,
+	0x18, 0xc5, 0xad, 0x1b, 0xb8, 0xdc, 0x88, 0x9b, 0x70, 0xf1, 0xed, 0x9f, 0x90, 0x16, 0x39, 0x98,
+	0x52, 0x32, 0x34, 0x16, 0xb4, 0x5b, 0x47, 0x43, 0x09, 0x19, 0xb2, 0xb8, 0xed, 0xd2, 0x25, 0x38,
+	0x89, 0xea, 0x88, 0xda, 0x92, 0xf0, 0x27, 0x43, 0xfd, 0x16, 0x7d, 0xac, 0x8c, 0x19, 0x4b, 0x07,
+	0x53, 0x92, 0xb9, 0x4b, 0x9e, 0xa0, 0x28, 0x4e, 0x68, 0x52, 0x32, 0x74, 0x92, 0x38, 0xdf, 0xe1,
+	0xf7, 0x49, 0xe7, 0xe0, 0x5a, 0xc2, 0x85, 0xa6, 0xfa, 0xd9, 0x53, 0xa9, 0xd4, 0xd2, 0xfe, 0xcb,
+	0x53, 0x0e, 0x93, 0x6c, 0xe8, 0x19, 0xb9, 0x79, 0x10, 0xc9, 0x48, 0xae, 0x06, 0xd4, 0xdc, 0x4a,
+	0x8f, 0xfb, 0x81, 0xf0, 0x51, 0x1f, 0xa2, 0x7b, 0x35, 0x0e, 0x35, 0xeb, 0x89, 0xc7, 0x38, 0xd4,
+	0x5c, 0x0a, 0x3f, 0x04, 0x66, 0x9f, 0xe2, 0x6a, 0x98, 0xe9, 0x89, 0x4c, 0xb9, 0xce, 0x1b, 0xa8,
+	0x85, 0xda, 0xd5, 0xe0, 0x4b, 0xb0, 0x05, 0xae, 0xf3, 0x8d, 0x7d, 0x30, 0x0c, 0x81, 0x35, 0x7e,
+	0x19, 0x8b, 0xdf, 0x9d, 0x16, 0x8e, 0xf5, 0x56, 0x38, 0xe7, 0x11, 0xd7, 0x66, 0xf7, 0x48, 0x26,
+	0x74, 0x24, 0x21, 0x91, 0xb0, 0x3e, 0x3a, 0x30, 0x7e, 0xa2, 0x3a, 0x57, 0x0c, 0xbc, 0x9e, 0xd0,
+	0xcb, 0xc2, 0x39, 0xcc, 0xc3, 0x24, 0xbe, 0x76, 0x77, 0x69, 0x6e, 0x50, 0xe3, 0x3b, 0xaf, 0xb9,
+	0xc1, 0xff, 0x52, 0x66, 0x26, 0x03, 0x2d, 0x07, 0x90, 0x29, 0x15, 0xe7, 0x8d, 0x4a, 0x0b, 0xb5,
+	0xff, 0xf8, 0x27, 0xcb, 0xc2, 0x39, 0x2e, 0x19, 0xdf, 0x1d, 0x6e, 0x50, 0x2f, 0xa5, 0x3b, 0x79,
+	0x5b, 0x0a, 0x2d, 0x4c, 0xf6, 0x7f, 0x37, 0x60, 0xa0, 0xa4, 0x00, 0x76, 0xa9, 0x70, 0xa5, 0x0f,
+	0x91, 0xcd, 0xf1, 0xff, 0x7d, 0xa1, 0x9c, 0x79, 0xdb, 0xa1, 0x7a, 0xfb, 0x59, 0xcd, 0x8b, 0x9f,
+	0xb8, 0x36, 0x1b, 0xfd, 0xee, 0x74, 0x4e, 0xd0, 0x6c, 0x4e, 0xd0, 0xfb, 0x9c, 0xa0, 0x97, 0x05,
+	0xb1, 0x66, 0x0b, 0x62, 0xbd, 0x2e, 0x88, 0xf5, 0xd0, 0xd9, 0x0a, 0xd1, 0x10, 0x05, 0xd3, 0x74,
+	0x4d, 0xa6, 0x89, 0x1c, 0x67, 0x31, 0x03, 0x5a, 0x76, 0x6e, 0xf2, 0x1c, 0xfe, 0x5e, 0x75, 0x7a,
+	0xf5, 0x39, 0x00, 0xfc, 0xa8, 0xb3, 0x13, 0x08, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateInflationBase defines a governance operation for updating the inflation base of the minter
+	UpdateInflationBase(ctx context.Context, in *MsgUpdateInflationBase, opts ...grpc.CallOption) (*MsgUpdateInflationBaseResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateInflationBase(ctx context.Context, in *MsgUpdateInflationBase, opts ...grpc.CallOption) (*MsgUpdateInflationBaseResponse, error) {
+	out := new(MsgUpdateInflationBaseResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Msg/UpdateInflationBase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateInflationBase defines a governance operation for updating the inflation base of the minter
+	UpdateInflationBase(context.Context, *MsgUpdateInflationBase) (*MsgUpdateInflationBaseResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateInflationBase(ctx context.Context, req *MsgUpdateInflationBase) (*MsgUpdateInflationBaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInflationBase not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateInflationBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInflationBase)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInflationBase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Msg/UpdateInflationBase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInflationBase(ctx, req.(*MsgUpdateInflationBase))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irishub.mint.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateInflationBase",
+			Handler:    _Msg_UpdateInflationBase_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mint/tx.proto",
+}
+
+func (m *MsgUpdateInflationBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInflationBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInflationBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RebaseToSupply {
+		i--
+		if m.RebaseToSupply {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.InflationBase.Size()
+		i -= size
+		if _, err := m.InflationBase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInflationBaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInflationBaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInflationBaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateInflationBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.InflationBase.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RebaseToSupply {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateInflationBaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateInflationBase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInflationBase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInflationBase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebaseToSupply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RebaseToSupply = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInflationBaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInflationBaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInflationBaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
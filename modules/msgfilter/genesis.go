@@ -0,0 +1,29 @@
+package msgfilter
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/msgfilter/keeper"
+	"github.com/irisnet/irishub/modules/msgfilter/types"
+)
+
+// InitGenesis stores the genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize msgfilter genesis state: %s", err.Error()))
+	}
+	k.SetParamSet(ctx, data.Params)
+}
+
+// ExportGenesis outputs the genesis state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParamSet(ctx))
+}
+
+// ValidateGenesis performs basic validation of msgfilter genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/msgfilter/types"
+)
+
+// Keeper of the msgfilter params
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper returns a msgfilter keeper
+func NewKeeper(paramSpace paramtypes.Subspace) Keeper {
+	return Keeper{
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// GetParamSet returns msgfilter params from the global param store
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParamSet sets msgfilter params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetDisabledMsgTypes returns the type URLs of the disabled messages
+func (k Keeper) GetDisabledMsgTypes(ctx sdk.Context) []string {
+	var msgTypes []string
+	k.paramSpace.Get(ctx, types.KeyDisabledMsgTypes, &msgTypes)
+	return msgTypes
+}
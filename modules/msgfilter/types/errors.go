@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// msgfilter module sentinel errors
+var (
+	ErrInvalidParams = sdkerrors.Register(ModuleName, 2, "invalid params")
+)
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	tests := []struct {
+		name             string
+		disabledMsgTypes []string
+		expectPass       bool
+	}{
+		{"default", DefaultParams().DisabledMsgTypes, true},
+		{"disabled msg types", []string{"/cosmos.bank.v1beta1.MsgSend", "/irismod.service.MsgCallService"}, true},
+		{"empty msg type", []string{""}, false},
+		{"msg type without slash", []string{"cosmos.bank.v1beta1.MsgSend"}, false},
+		{"msg type with spaces", []string{"/cosmos.bank.v1beta1.MsgSend "}, false},
+		{"duplicate msg type", []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}, false},
+		{"governance msg type", []string{"/cosmos.gov.v1.MsgVote"}, false},
+		{"legacy governance msg type", []string{"/cosmos.gov.v1beta1.MsgSubmitProposal"}, false},
+	}
+	for _, tc := range tests {
+		err := ValidateGenesis(*NewGenesisState(NewParams(tc.disabledMsgTypes)))
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
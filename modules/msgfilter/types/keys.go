@@ -0,0 +1,13 @@
+package types
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "msgfilter"
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
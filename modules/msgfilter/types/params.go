@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyDisabledMsgTypes = []byte("DisabledMsgTypes")
+)
+
+// governance messages can never be disabled, or the disabled messages could not be enabled again
+var governanceMsgTypes = map[string]bool{
+	sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}):      true,
+	sdk.MsgTypeURL(&govv1.MsgDeposit{}):             true,
+	sdk.MsgTypeURL(&govv1.MsgVote{}):                true,
+	sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}):        true,
+	sdk.MsgTypeURL(&govv1beta1.MsgSubmitProposal{}): true,
+	sdk.MsgTypeURL(&govv1beta1.MsgDeposit{}):        true,
+	sdk.MsgTypeURL(&govv1beta1.MsgVote{}):           true,
+	sdk.MsgTypeURL(&govv1beta1.MsgVoteWeighted{}):   true,
+}
+
+// ParamKeyTable for msgfilter module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs a Params
+func NewParams(disabledMsgTypes []string) Params {
+	return Params{
+		DisabledMsgTypes: disabledMsgTypes,
+	}
+}
+
+// DefaultParams returns default msgfilter module parameters, under which no message is disabled
+func DefaultParams() Params {
+	return Params{
+		DisabledMsgTypes: []string{},
+	}
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDisabledMsgTypes, &p.DisabledMsgTypes, validateDisabledMsgTypes),
+	}
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateDisabledMsgTypes(p.DisabledMsgTypes); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
+
+func validateDisabledMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, msgType := range v {
+		if !strings.HasPrefix(msgType, "/") || len(strings.TrimSpace(msgType)) != len(msgType) {
+			return fmt.Errorf("invalid message type url %q", msgType)
+		}
+		if governanceMsgTypes[msgType] {
+			return fmt.Errorf("governance message %s can not be disabled", msgType)
+		}
+		if seen[msgType] {
+			return fmt.Errorf("duplicate message type url %s", msgType)
+		}
+		seen[msgType] = true
+	}
+	return nil
+}
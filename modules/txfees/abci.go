@@ -0,0 +1,14 @@
+package txfees
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/txfees/keeper"
+)
+
+// EndBlocker swaps the fees collected in the fee tokens to the native token and records
+// the prices of the fee tokens for the next block
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.SwapFees(ctx)
+	k.UpdateFeeTokenPrices(ctx)
+}
@@ -0,0 +1,45 @@
+package txfees
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/txfees/keeper"
+	"github.com/irisnet/irishub/modules/txfees/types"
+)
+
+// InitGenesis stores the genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize txfees genesis state: %s", err.Error()))
+	}
+	k.SetParamSet(ctx, data.Params)
+	for _, price := range data.Prices {
+		k.SetFeeTokenPrice(ctx, price)
+	}
+	for _, observation := range data.Observations {
+		k.SetPriceObservation(ctx, observation)
+	}
+}
+
+// ExportGenesis outputs the genesis state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	prices := []types.FeeTokenPrice{}
+	k.IterateFeeTokenPrices(ctx, func(price types.FeeTokenPrice) bool {
+		prices = append(prices, price)
+		return false
+	})
+	observations := []types.PriceObservation{}
+	k.IteratePriceObservations(ctx, func(observation types.PriceObservation) bool {
+		observations = append(observations, observation)
+		return false
+	})
+	return types.NewGenesisState(k.GetParamSet(ctx), prices, observations)
+}
+
+// ValidateGenesis performs basic validation of txfees genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/txfees/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the txfees parameters
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParamSet(ctx)}, nil
+}
+
+// FeeTokenPrices returns the recorded prices of the fee tokens
+func (k Keeper) FeeTokenPrices(c context.Context, req *types.QueryFeeTokenPricesRequest) (*types.QueryFeeTokenPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	prices := []types.FeeTokenPrice{}
+	k.IterateFeeTokenPrices(ctx, func(price types.FeeTokenPrice) bool {
+		prices = append(prices, price)
+		return false
+	})
+	return &types.QueryFeeTokenPricesResponse{Prices: prices}, nil
+}
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	coinswapkeeper "github.com/irisnet/irismod/modules/coinswap/keeper"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+
+	"github.com/irisnet/irishub/modules/txfees/types"
+)
+
+// Keeper of the txfees store
+type Keeper struct {
+	cdc              codec.Codec
+	storeKey         storetypes.StoreKey
+	paramSpace       paramtypes.Subspace
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	coinswapKeeper   types.CoinswapKeeper
+	feeCollectorName string
+}
+
+// NewKeeper returns a txfees keeper
+func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, ck types.CoinswapKeeper, feeCollectorName string) Keeper {
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		coinswapKeeper:   ck,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// GetParamSet returns txfees params from the global param store
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParamSet sets txfees params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetNativeDenom returns the denom of the native token, which is the standard denom of coinswap
+func (k Keeper) GetNativeDenom(ctx sdk.Context) string {
+	return k.coinswapKeeper.GetStandardDenom(ctx)
+}
+
+// ConvertFees converts the fees paid in the fee tokens to the native token, while the other fees
+// are returned as is. The fees which can't be converted, e.g. for lack of a fresh price, are returned
+// as is as well, so that they are only checked against the minimum gas prices in their own denoms
+// and the remaining fees can still meet the minimum gas prices in the native token.
+func (k Keeper) ConvertFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins {
+	params := k.GetParamSet(ctx)
+
+	converted := sdk.NewCoins()
+	for _, fee := range fees {
+		if !params.IsFeeToken(fee.Denom) {
+			converted = converted.Add(fee)
+			continue
+		}
+		nativeFee, err := k.ConvertFee(ctx, params, fee)
+		if err != nil {
+			k.Logger(ctx).Debug("Skip converting fee", "fee", fee.String(), "err", err.Error())
+			converted = converted.Add(fee)
+			continue
+		}
+		converted = converted.Add(nativeFee)
+	}
+	return converted
+}
+
+// ConvertFee returns the amount of the native token which the fee paid in a fee token is worth, i.e.
+// the amount its coinswap pool swaps it for, capped by the value at the recorded time-weighted average
+// price of the fee token, so that a pool moved within the latest blocks can't inflate the value of the fee.
+// The swap output must not fall below the value at the recorded price by more than the max slippage,
+// and the price must not be older than the max price age.
+func (k Keeper) ConvertFee(ctx sdk.Context, params types.Params, fee sdk.Coin) (sdk.Coin, error) {
+	price, found := k.GetFeeTokenPrice(ctx, fee.Denom)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNoPrice, "no price of %s", fee.Denom)
+	}
+	if price.IsStale(ctx.BlockHeight(), params.MaxPriceAge) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrStalePrice, "price of %s was recorded at height %d", fee.Denom, price.Height)
+	}
+
+	nativeDenom := k.GetNativeDenom(ctx)
+	reserves, err := k.getPoolReserves(ctx, fee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	output := coinswapkeeper.GetInputPrice(
+		fee.Amount, reserves.AmountOf(fee.Denom), reserves.AmountOf(nativeDenom), k.coinswapKeeper.GetParams(ctx).Fee,
+	)
+
+	minOutput := price.Value(fee.Amount).Mul(sdk.OneDec().Sub(params.MaxSlippage))
+	if minOutput.GT(sdk.NewDecFromInt(output)) {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrSlippageExceeded, "%s is swapped for %s%s, expected at least %s%s",
+			fee, output, nativeDenom, minOutput, nativeDenom,
+		)
+	}
+	if value := price.Value(fee.Amount).TruncateInt(); value.LT(output) {
+		output = value
+	}
+	return sdk.NewCoin(nativeDenom, output), nil
+}
+
+// UpdateFeeTokenPrices observes the spot prices of the fee tokens from their coinswap pools, and records
+// the time-weighted average of the prices observed within the twap window. The prices of the tokens which
+// are no longer accepted as fees are deleted. The price of a fee token without liquidity is left to become stale.
+func (k Keeper) UpdateFeeTokenPrices(ctx sdk.Context) {
+	params := k.GetParamSet(ctx)
+	nativeDenom := k.GetNativeDenom(ctx)
+
+	k.IterateFeeTokenPrices(ctx, func(price types.FeeTokenPrice) bool {
+		if !params.IsFeeToken(price.Denom) {
+			k.DeleteFeeTokenPrice(ctx, price.Denom)
+		}
+		return false
+	})
+	removed := make(map[string]bool)
+	k.IteratePriceObservations(ctx, func(observation types.PriceObservation) bool {
+		if !params.IsFeeToken(observation.Denom) {
+			removed[observation.Denom] = true
+		}
+		return false
+	})
+	for denom := range removed {
+		k.PrunePriceObservations(ctx, denom, ctx.BlockHeight())
+	}
+
+	for _, denom := range params.FeeTokens {
+		reserves, err := k.getPoolReserves(ctx, denom)
+		if err != nil {
+			continue
+		}
+		tokenReserve, nativeReserve := reserves.AmountOf(denom), reserves.AmountOf(nativeDenom)
+		if !tokenReserve.IsPositive() || !nativeReserve.IsPositive() {
+			continue
+		}
+		spotPrice := sdk.NewDecFromInt(nativeReserve).QuoInt(tokenReserve)
+		k.SetPriceObservation(ctx, types.NewPriceObservation(denom, spotPrice, ctx.BlockHeight(), ctx.BlockTime()))
+		k.PrunePriceObservations(ctx, denom, ctx.BlockHeight()-int64(params.TwapWindow))
+
+		price := types.TimeWeightedAveragePrice(k.GetPriceObservations(ctx, denom))
+		k.SetFeeTokenPrice(ctx, types.NewFeeTokenPrice(denom, price, ctx.BlockHeight()))
+	}
+}
+
+// SwapFees swaps the fees collected in the fee tokens to the native token in the fee collector,
+// under the same slippage and price age bounds as the conversion of the fees
+func (k Keeper) SwapFees(ctx sdk.Context) {
+	params := k.GetParamSet(ctx)
+	if !params.SwapFees {
+		return
+	}
+
+	feeCollector := k.accountKeeper.GetModuleAccount(ctx, k.feeCollectorName).GetAddress()
+	for _, denom := range params.FeeTokens {
+		fee := k.bankKeeper.GetBalance(ctx, feeCollector, denom)
+		if !fee.IsPositive() {
+			continue
+		}
+		nativeFee, err := k.ConvertFee(ctx, params, fee)
+		if err != nil {
+			k.Logger(ctx).Error("Skip swapping fees", "fee", fee.String(), "err", err.Error())
+			continue
+		}
+		if !nativeFee.IsPositive() {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.coinswapKeeper.Swap(cacheCtx, &coinswaptypes.MsgSwapOrder{
+			Input:      coinswaptypes.Input{Address: feeCollector.String(), Coin: fee},
+			Output:     coinswaptypes.Output{Address: feeCollector.String(), Coin: nativeFee},
+			Deadline:   ctx.BlockTime().Unix(),
+			IsBuyOrder: false,
+		}); err != nil {
+			k.Logger(ctx).Error("Failed to swap fees", "fee", fee.String(), "err", err.Error())
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapFees,
+				sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+				sdk.NewAttribute(types.AttributeKeyNativeFee, nativeFee.String()),
+			),
+		)
+	}
+}
+
+// getPoolReserves returns the reserves of the coinswap pool of the fee token
+func (k Keeper) getPoolReserves(ctx sdk.Context, denom string) (sdk.Coins, error) {
+	lptDenom, err := k.coinswapKeeper.GetLptDenomFromDenoms(ctx, denom, k.GetNativeDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return k.coinswapKeeper.GetPoolBalancesByLptDenom(ctx, lptDenom)
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/modules/txfees/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+const feeToken = "uatom"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *simapp.SimApp
+	nativeDenom string
+	provider    sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	suite.app = app
+	suite.nativeDenom = app.CoinswapKeeper.GetStandardDenom(suite.ctx)
+	suite.provider = sdk.AccAddress(tmcrypto.AddressHash([]byte("provider")))
+
+	// a pool of 1 native token per 2 fee tokens
+	suite.fund(suite.provider, sdk.NewCoins(
+		sdk.NewInt64Coin(suite.nativeDenom, 2_000_000_000),
+		sdk.NewInt64Coin(feeToken, 2_000_000_000),
+	))
+	_, err := app.CoinswapKeeper.AddLiquidity(suite.ctx, &coinswaptypes.MsgAddLiquidity{
+		MaxToken:         sdk.NewInt64Coin(feeToken, 1_000_000_000),
+		ExactStandardAmt: sdk.NewInt(500_000_000),
+		MinLiquidity:     sdk.NewInt(500_000_000),
+		Deadline:         suite.ctx.BlockTime().Unix() + 100,
+		Sender:           suite.provider.String(),
+	})
+	suite.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.FeeTokens = []string{feeToken}
+	app.TxFeesKeeper.SetParamSet(suite.ctx, params)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) fund(addr sdk.AccAddress, coins sdk.Coins) {
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, addr, coins))
+}
+
+func (suite *KeeperTestSuite) swap(input, output sdk.Coin) {
+	trader := sdk.AccAddress(tmcrypto.AddressHash([]byte("trader")))
+	suite.fund(trader, sdk.NewCoins(input))
+	suite.Require().NoError(suite.app.CoinswapKeeper.Swap(suite.ctx, &coinswaptypes.MsgSwapOrder{
+		Input:  coinswaptypes.Input{Address: trader.String(), Coin: input},
+		Output: coinswaptypes.Output{Address: trader.String(), Coin: output},
+	}))
+}
+
+func (suite *KeeperTestSuite) TestUpdateFeeTokenPrices() {
+	_, found := suite.app.TxFeesKeeper.GetFeeTokenPrice(suite.ctx, feeToken)
+	suite.False(found)
+
+	suite.app.TxFeesKeeper.SetFeeTokenPrice(suite.ctx, types.NewFeeTokenPrice("removed", sdk.OneDec(), 1))
+	suite.app.TxFeesKeeper.SetPriceObservation(suite.ctx, types.NewPriceObservation("removed", sdk.OneDec(), 1, suite.ctx.BlockTime()))
+	suite.app.TxFeesKeeper.UpdateFeeTokenPrices(suite.ctx)
+
+	price, found := suite.app.TxFeesKeeper.GetFeeTokenPrice(suite.ctx, feeToken)
+	suite.True(found)
+	suite.Equal(types.NewFeeTokenPrice(feeToken, sdk.NewDecWithPrec(5, 1), suite.ctx.BlockHeight()), price)
+
+	// the prices of the tokens no longer accepted as fees are deleted
+	_, found = suite.app.TxFeesKeeper.GetFeeTokenPrice(suite.ctx, "removed")
+	suite.False(found)
+	suite.Empty(suite.app.TxFeesKeeper.GetPriceObservations(suite.ctx, "removed"))
+}
+
+func (suite *KeeperTestSuite) TestUpdateFeeTokenPricesTWAP() {
+	suite.app.TxFeesKeeper.UpdateFeeTokenPrices(suite.ctx)
+
+	// the pool is moved to 1 native token per 8 fee tokens, which lasts for 5 seconds before the next block
+	suite.swap(sdk.NewInt64Coin(feeToken, 1_000_000_000), sdk.NewInt64Coin(suite.nativeDenom, 1))
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(5 * time.Second))
+	suite.app.TxFeesKeeper.UpdateFeeTokenPrices(suite.ctx)
+
+	// the latest spot price has no weight until the next observation
+	price, _ := suite.app.TxFeesKeeper.GetFeeTokenPrice(suite.ctx, feeToken)
+	suite.Equal(sdk.NewDecWithPrec(5, 1), price.Price)
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(15 * time.Second))
+	suite.app.TxFeesKeeper.UpdateFeeTokenPrices(suite.ctx)
+	price, _ = suite.app.TxFeesKeeper.GetFeeTokenPrice(suite.ctx, feeToken)
+	suite.True(price.Price.LT(sdk.NewDecWithPrec(5, 1)))
+	suite.True(price.Price.GT(sdk.NewDecWithPrec(125, 3)))
+	suite.Len(suite.app.TxFeesKeeper.GetPriceObservations(suite.ctx, feeToken), 3)
+
+	// the observations out of the twap window are pruned
+	params := suite.app.TxFeesKeeper.GetParamSet(suite.ctx)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + int64(params.TwapWindow) - 1)
+	suite.app.TxFeesKeeper.UpdateFeeTokenPrices(suite.ctx)
+	suite.Len(suite.app.TxFeesKeeper.GetPriceObservations(suite.ctx, feeToken), 2)
+}
+
+func (suite *KeeperTestSuite) TestConvertFees() {
+	suite.app.TxFeesKeeper.UpdateFeeTokenPrices(suite.ctx)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(feeToken, 10000), sdk.NewInt64Coin("other", 100))
+	converted := suite.app.TxFeesKeeper.ConvertFees(suite.ctx, fees)
+
+	// the fee token is swapped for slightly less than half of the native token deducting the swap fee,
+	// while the other fees are kept as is
+	native := converted.AmountOf(suite.nativeDenom)
+	suite.True(native.IsPositive())
+	suite.True(native.LT(sdk.NewInt(5000)))
+	suite.True(native.GTE(sdk.NewDecWithPrec(95, 2).MulInt64(5000).TruncateInt()))
+	suite.True(converted.AmountOf(feeToken).IsZero())
+	suite.Equal(sdk.NewInt(100), converted.AmountOf("other"))
+}
+
+func (suite *KeeperTestSuite) TestConvertFeesSkipped() {
+	// the fees which can't be converted are kept as is instead of failing the others
+	fees := sdk.NewCoins(sdk.NewInt64Coin(feeToken, 10000), sdk.NewInt64Coin(suite.nativeDenom, 100))
+	suite.Equal(fees, suite.app.TxFeesKeeper.ConvertFees(suite.ctx, fees))
+}
+
+func (suite *KeeperTestSuite) TestConvertFeeNoPrice() {
+	params := suite.app.TxFeesKeeper.GetParamSet(suite.ctx)
+	_, err := suite.app.TxFeesKeeper.ConvertFee(suite.ctx, params, sdk.NewInt64Coin(feeToken, 10000))
+	suite.ErrorIs(err, types.ErrNoPrice)
+}
+
+func (suite *KeeperTestSuite) TestConvertFeeStalePrice() {
+	suite.app.TxFeesKeeper.UpdateFeeTokenPrices(suite.ctx)
+	params := suite.app.TxFeesKeeper.GetParamSet(suite.ctx)
+	fee := sdk.NewInt64Coin(feeToken, 10000)
+
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + int64(types.DefaultMaxPriceAge))
+	_, err := suite.app.TxFeesKeeper.ConvertFee(ctx, params, fee)
+	suite.NoError(err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = suite.app.TxFeesKeeper.ConvertFee(ctx, params, fee)
+	suite.ErrorIs(err, types.ErrStalePrice)
+}
+
+func (suite *KeeperTestSuite) TestConvertFeeSlippage() {
+	suite.app.TxFeesKeeper.UpdateFeeTokenPrices(suite.ctx)
+	params := suite.app.TxFeesKeeper.GetParamSet(suite.ctx)
+
+	// a fee large compared to the pool moves the price beyond the max slippage
+	_, err := suite.app.TxFeesKeeper.ConvertFee(suite.ctx, params, sdk.NewInt64Coin(feeToken, 100_000_000))
+	suite.ErrorIs(err, types.ErrSlippageExceeded)
+
+	// so does a pool manipulated after the price was recorded
+	suite.swap(sdk.NewInt64Coin(feeToken, 200_000_000), sdk.NewInt64Coin(suite.nativeDenom, 1))
+	_, err = suite.app.TxFeesKeeper.ConvertFee(suite.ctx, params, sdk.NewInt64Coin(feeToken, 10000))
+	suite.ErrorIs(err, types.ErrSlippageExceeded)
+}
+
+func (suite *KeeperTestSuite) TestConvertFeeCappedByPrice() {
+	suite.app.TxFeesKeeper.UpdateFeeTokenPrices(suite.ctx)
+	params := suite.app.TxFeesKeeper.GetParamSet(suite.ctx)
+
+	// a pool pumped after the price was recorded can't inflate the value of the fee beyond the price
+	suite.swap(sdk.NewInt64Coin(suite.nativeDenom, 100_000_000), sdk.NewInt64Coin(feeToken, 1))
+	converted, err := suite.app.TxFeesKeeper.ConvertFee(suite.ctx, params, sdk.NewInt64Coin(feeToken, 10000))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt64Coin(suite.nativeDenom, 5000), converted)
+}
+
+func (suite *KeeperTestSuite) TestSwapFees() {
+	suite.app.TxFeesKeeper.UpdateFeeTokenPrices(suite.ctx)
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	fee := sdk.NewInt64Coin(feeToken, 10000)
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, sdk.NewCoins(fee)))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(
+		suite.ctx, minttypes.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(fee),
+	))
+	nativeBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, suite.nativeDenom)
+
+	// the fees are not swapped by default
+	suite.app.TxFeesKeeper.SwapFees(suite.ctx)
+	suite.Equal(fee, suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, feeToken))
+
+	params := suite.app.TxFeesKeeper.GetParamSet(suite.ctx)
+	params.SwapFees = true
+	suite.app.TxFeesKeeper.SetParamSet(suite.ctx, params)
+
+	expected, err := suite.app.TxFeesKeeper.ConvertFee(suite.ctx, params, fee)
+	suite.Require().NoError(err)
+	suite.app.TxFeesKeeper.SwapFees(suite.ctx)
+	suite.True(suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, feeToken).IsZero())
+	suite.Equal(nativeBalance.Add(expected), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, suite.nativeDenom))
+}
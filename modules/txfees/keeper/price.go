@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/txfees/types"
+)
+
+// SetFeeTokenPrice stores the recorded price of a fee token
+func (k Keeper) SetFeeTokenPrice(ctx sdk.Context, price types.FeeTokenPrice) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&price)
+	store.Set(types.GetFeeTokenPriceKey(price.Denom), bz)
+}
+
+// GetFeeTokenPrice returns the recorded price of the specified fee token
+func (k Keeper) GetFeeTokenPrice(ctx sdk.Context, denom string) (price types.FeeTokenPrice, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeTokenPriceKey(denom))
+	if bz == nil {
+		return price, false
+	}
+	k.cdc.MustUnmarshal(bz, &price)
+	return price, true
+}
+
+// DeleteFeeTokenPrice deletes the recorded price of the specified fee token
+func (k Keeper) DeleteFeeTokenPrice(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeeTokenPriceKey(denom))
+}
+
+// IterateFeeTokenPrices iterates through the recorded prices of the fee tokens
+func (k Keeper) IterateFeeTokenPrices(ctx sdk.Context, op func(price types.FeeTokenPrice) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FeeTokenPriceKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.FeeTokenPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		if op(price) {
+			break
+		}
+	}
+}
+
+// SetPriceObservation stores the spot price of a fee token observed at a height
+func (k Keeper) SetPriceObservation(ctx sdk.Context, observation types.PriceObservation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&observation)
+	store.Set(types.GetPriceObservationKey(observation.Denom, observation.Height), bz)
+}
+
+// GetPriceObservations returns the observed spot prices of the specified fee token by increasing heights
+func (k Keeper) GetPriceObservations(ctx sdk.Context, denom string) (observations []types.PriceObservation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPriceObservationsKey(denom))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		observations = append(observations, observation)
+	}
+	return observations
+}
+
+// IteratePriceObservations iterates through the observed spot prices of all the fee tokens
+func (k Keeper) IteratePriceObservations(ctx sdk.Context, op func(observation types.PriceObservation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PriceObservationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		if op(observation) {
+			break
+		}
+	}
+}
+
+// PrunePriceObservations deletes the observed spot prices of the specified fee token up to the height
+func (k Keeper) PrunePriceObservations(ctx sdk.Context, denom string, height int64) {
+	if height < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetPriceObservationsKey(denom), types.GetPriceObservationKey(denom, height+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// txfees module sentinel errors
+var (
+	ErrInvalidParams     = sdkerrors.Register(ModuleName, 2, "invalid params")
+	ErrNoPrice           = sdkerrors.Register(ModuleName, 3, "no price of the fee token")
+	ErrStalePrice        = sdkerrors.Register(ModuleName, 4, "stale price of the fee token")
+	ErrSlippageExceeded  = sdkerrors.Register(ModuleName, 5, "max slippage exceeded")
+	ErrInvalidPriceEntry = sdkerrors.Register(ModuleName, 6, "invalid fee token price")
+)
@@ -0,0 +1,10 @@
+// nolint
+package types
+
+// txfees module event types
+const (
+	EventTypeSwapFees = "swap_fees"
+
+	AttributeKeyFee       = "fee"
+	AttributeKeyNativeFee = "native_fee"
+)
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// CoinswapKeeper defines the expected coinswap keeper
+type CoinswapKeeper interface {
+	GetStandardDenom(ctx sdk.Context) string
+	GetParams(ctx sdk.Context) coinswaptypes.Params
+	GetLptDenomFromDenoms(ctx sdk.Context, denom1, denom2 string) (string, error)
+	GetPoolBalancesByLptDenom(ctx sdk.Context, lptDenom string) (sdk.Coins, error)
+	Swap(ctx sdk.Context, msg *coinswaptypes.MsgSwapOrder) error
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState constructs a GenesisState
+func NewGenesisState(params Params, prices []FeeTokenPrice, observations []PriceObservation) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		Prices:       prices,
+		Observations: observations,
+	}
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:       DefaultParams(),
+		Prices:       []FeeTokenPrice{},
+		Observations: []PriceObservation{},
+	}
+}
+
+// ValidateGenesis validates the provided txfees genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.Prices))
+	for _, price := range data.Prices {
+		if err := price.Validate(); err != nil {
+			return err
+		}
+		if seen[price.Denom] {
+			return fmt.Errorf("duplicate price of %s", price.Denom)
+		}
+		seen[price.Denom] = true
+	}
+
+	lastHeights := make(map[string]int64)
+	for _, observation := range data.Observations {
+		if err := observation.Validate(); err != nil {
+			return err
+		}
+		if lastHeight, ok := lastHeights[observation.Denom]; ok && observation.Height <= lastHeight {
+			return fmt.Errorf("price observations of %s should be in increasing heights", observation.Denom)
+		}
+		lastHeights[observation.Denom] = observation.Height
+	}
+	return nil
+}
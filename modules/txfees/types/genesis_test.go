@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	withParams := func(feeTokens []string, maxSlippage sdk.Dec) GenesisState {
+		genesis := DefaultGenesisState()
+		genesis.Params.FeeTokens = feeTokens
+		genesis.Params.MaxSlippage = maxSlippage
+		return *genesis
+	}
+	withTWAPWindow := func(twapWindow uint64) GenesisState {
+		genesis := DefaultGenesisState()
+		genesis.Params.TwapWindow = twapWindow
+		return *genesis
+	}
+	withPrices := func(prices ...FeeTokenPrice) GenesisState {
+		genesis := DefaultGenesisState()
+		genesis.Prices = prices
+		return *genesis
+	}
+	withObservations := func(observations ...PriceObservation) GenesisState {
+		genesis := DefaultGenesisState()
+		genesis.Observations = observations
+		return *genesis
+	}
+	price := NewFeeTokenPrice("uatom", sdk.NewDecWithPrec(5, 1), 10)
+	observation := func(denom string, price sdk.Dec, height int64) PriceObservation {
+		return NewPriceObservation(denom, price, height, time.Unix(height*5, 0))
+	}
+
+	tests := []struct {
+		name       string
+		genesis    GenesisState
+		expectPass bool
+	}{
+		{"default", *DefaultGenesisState(), true},
+		{"fee tokens", withParams([]string{"uatom", "uosmo"}, DefaultMaxSlippage), true},
+		{"invalid fee token", withParams([]string{"a"}, DefaultMaxSlippage), false},
+		{"duplicate fee token", withParams([]string{"uatom", "uatom"}, DefaultMaxSlippage), false},
+		{"zero max slippage", withParams(nil, sdk.ZeroDec()), true},
+		{"negative max slippage", withParams(nil, sdk.NewDecWithPrec(-1, 2)), false},
+		{"max slippage of one", withParams(nil, sdk.OneDec()), false},
+		{"price", withPrices(price), true},
+		{"duplicate price", withPrices(price, price), false},
+		{"zero price", withPrices(NewFeeTokenPrice("uatom", sdk.ZeroDec(), 10)), false},
+		{"negative height", withPrices(NewFeeTokenPrice("uatom", sdk.OneDec(), -1)), false},
+		{"zero twap window", withTWAPWindow(0), false},
+		{"observations", withObservations(observation("uatom", sdk.OneDec(), 9), observation("uosmo", sdk.OneDec(), 9), observation("uatom", sdk.OneDec(), 10)), true},
+		{"decreasing observation heights", withObservations(observation("uatom", sdk.OneDec(), 10), observation("uatom", sdk.OneDec(), 9)), false},
+		{"duplicate observation", withObservations(observation("uatom", sdk.OneDec(), 10), observation("uatom", sdk.OneDec(), 10)), false},
+		{"zero observed price", withObservations(observation("uatom", sdk.ZeroDec(), 10)), false},
+	}
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, ValidateGenesis(tc.genesis), tc.name)
+		} else {
+			require.Error(t, ValidateGenesis(tc.genesis), tc.name)
+		}
+	}
+}
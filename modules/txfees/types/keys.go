@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "txfees"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	FeeTokenPriceKey    = []byte{0x00} // key for the recorded prices of the fee tokens
+	PriceObservationKey = []byte{0x01} // key for the observed spot prices of the fee tokens
+)
+
+// GetFeeTokenPriceKey returns the key of the recorded price of the specified fee token
+func GetFeeTokenPriceKey(denom string) []byte {
+	return append(FeeTokenPriceKey, []byte(denom)...)
+}
+
+// GetPriceObservationsKey returns the key prefix of the observed spot prices of the specified fee token
+func GetPriceObservationsKey(denom string) []byte {
+	key := append(append([]byte{}, PriceObservationKey...), []byte(denom)...)
+	return append(key, 0x00)
+}
+
+// GetPriceObservationKey returns the key of the spot price of the specified fee token observed at the height
+func GetPriceObservationKey(denom string, height int64) []byte {
+	return append(GetPriceObservationsKey(denom), sdk.Uint64ToBigEndian(uint64(height))...)
+}
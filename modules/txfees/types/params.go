@@ -0,0 +1,157 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyFeeTokens   = []byte("FeeTokens")
+	KeyMaxSlippage = []byte("MaxSlippage")
+	KeyMaxPriceAge = []byte("MaxPriceAge")
+	KeySwapFees    = []byte("SwapFees")
+	KeyTWAPWindow  = []byte("TWAPWindow")
+)
+
+// Default parameter values
+var (
+	DefaultMaxSlippage        = sdk.NewDecWithPrec(5, 2)
+	DefaultMaxPriceAge uint64 = 10
+	DefaultTWAPWindow  uint64 = 30
+)
+
+// ParamKeyTable for txfees module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs a Params
+func NewParams(feeTokens []string, maxSlippage sdk.Dec, maxPriceAge uint64, swapFees bool, twapWindow uint64) Params {
+	return Params{
+		FeeTokens:   feeTokens,
+		MaxSlippage: maxSlippage,
+		MaxPriceAge: maxPriceAge,
+		SwapFees:    swapFees,
+		TwapWindow:  twapWindow,
+	}
+}
+
+// DefaultParams returns default txfees module parameters, under which no fee token is accepted
+func DefaultParams() Params {
+	return Params{
+		FeeTokens:   []string{},
+		MaxSlippage: DefaultMaxSlippage,
+		MaxPriceAge: DefaultMaxPriceAge,
+		SwapFees:    false,
+		TwapWindow:  DefaultTWAPWindow,
+	}
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeTokens, &p.FeeTokens, validateFeeTokens),
+		paramtypes.NewParamSetPair(KeyMaxSlippage, &p.MaxSlippage, validateMaxSlippage),
+		paramtypes.NewParamSetPair(KeyMaxPriceAge, &p.MaxPriceAge, validateMaxPriceAge),
+		paramtypes.NewParamSetPair(KeySwapFees, &p.SwapFees, validateSwapFees),
+		paramtypes.NewParamSetPair(KeyTWAPWindow, &p.TwapWindow, validateTWAPWindow),
+	}
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateFeeTokens(p.FeeTokens); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	if err := validateMaxSlippage(p.MaxSlippage); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	if err := validateMaxPriceAge(p.MaxPriceAge); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	if err := validateTWAPWindow(p.TwapWindow); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
+
+// IsFeeToken returns true if the denom is accepted as fees
+func (p Params) IsFeeToken(denom string) bool {
+	for _, feeToken := range p.FeeTokens {
+		if feeToken == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func validateFeeTokens(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate fee token %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+func validateMaxSlippage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max slippage must be in [0, 1): %s", v)
+	}
+	return nil
+}
+
+func validateMaxPriceAge(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSwapFees(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTWAPWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("twap window must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewFeeTokenPrice creates a new FeeTokenPrice instance
+func NewFeeTokenPrice(denom string, price sdk.Dec, height int64) FeeTokenPrice {
+	return FeeTokenPrice{
+		Denom:  denom,
+		Price:  price,
+		Height: height,
+	}
+}
+
+// Validate returns err if the FeeTokenPrice is invalid
+func (p FeeTokenPrice) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPriceEntry, err.Error())
+	}
+	if p.Price.IsNil() || !p.Price.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidPriceEntry, "price of %s must be positive: %s", p.Denom, p.Price)
+	}
+	if p.Height < 0 {
+		return sdkerrors.Wrapf(ErrInvalidPriceEntry, "height of the price of %s must not be negative: %d", p.Denom, p.Height)
+	}
+	return nil
+}
+
+// IsStale returns true if the price was recorded more than maxAge blocks before the height
+func (p FeeTokenPrice) IsStale(height int64, maxAge uint64) bool {
+	return height-p.Height > int64(maxAge)
+}
+
+// Value returns the amount of the native token worth the amount of the fee token at the price
+func (p FeeTokenPrice) Value(amount sdk.Int) sdk.Dec {
+	return p.Price.MulInt(amount)
+}
+
+// NewPriceObservation creates a new PriceObservation instance
+func NewPriceObservation(denom string, price sdk.Dec, height int64, time time.Time) PriceObservation {
+	return PriceObservation{
+		Denom:  denom,
+		Price:  price,
+		Height: height,
+		Time:   time,
+	}
+}
+
+// Validate returns err if the PriceObservation is invalid
+func (o PriceObservation) Validate() error {
+	return NewFeeTokenPrice(o.Denom, o.Price, o.Height).Validate()
+}
+
+// TimeWeightedAveragePrice returns the average of the spot prices observed in increasing heights, each
+// weighted by the time until the next observation. The latest observation carries no weight yet, so a
+// pool moved within a block does not move the average until it stays so for a while. The prices are
+// averaged evenly if no time has elapsed between the observations.
+func TimeWeightedAveragePrice(observations []PriceObservation) sdk.Dec {
+	if len(observations) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	weighted, total := sdk.ZeroDec(), sdk.ZeroDec()
+	for i := 0; i < len(observations)-1; i++ {
+		elapsed := sdk.NewDec(observations[i+1].Time.Sub(observations[i].Time).Milliseconds())
+		if !elapsed.IsPositive() {
+			continue
+		}
+		weighted = weighted.Add(observations[i].Price.Mul(elapsed))
+		total = total.Add(elapsed)
+	}
+	if total.IsPositive() {
+		return weighted.Quo(total)
+	}
+
+	sum := sdk.ZeroDec()
+	for _, observation := range observations {
+		sum = sum.Add(observation.Price)
+	}
+	return sum.QuoInt64(int64(len(observations)))
+}
@@ -116,12 +116,21 @@ import (
 
 	"github.com/irisnet/irishub/address"
 	"github.com/irisnet/irishub/lite"
+	"github.com/irisnet/irishub/modules/globalfee"
+	globalfeekeeper "github.com/irisnet/irishub/modules/globalfee/keeper"
+	globalfeetypes "github.com/irisnet/irishub/modules/globalfee/types"
 	"github.com/irisnet/irishub/modules/guardian"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
 	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 	"github.com/irisnet/irishub/modules/mint"
 	mintkeeper "github.com/irisnet/irishub/modules/mint/keeper"
 	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/modules/msgfilter"
+	msgfilterkeeper "github.com/irisnet/irishub/modules/msgfilter/keeper"
+	msgfiltertypes "github.com/irisnet/irishub/modules/msgfilter/types"
+	"github.com/irisnet/irishub/modules/txfees"
+	txfeeskeeper "github.com/irisnet/irishub/modules/txfees/keeper"
+	txfeestypes "github.com/irisnet/irishub/modules/txfees/types"
 
 	"github.com/irisnet/irismod/modules/farm"
 	farmkeeper "github.com/irisnet/irismod/modules/farm/keeper"
@@ -188,6 +197,9 @@ var (
 		feegrantmodule.AppModuleBasic{},
 
 		guardian.AppModuleBasic{},
+		txfees.AppModuleBasic{},
+		globalfee.AppModuleBasic{},
+		msgfilter.AppModuleBasic{},
 		token.AppModuleBasic{},
 		record.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
@@ -207,7 +219,7 @@ var (
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:     nil,
 		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
+		minttypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
@@ -276,6 +288,9 @@ type SimApp struct {
 	scopedTIBCMockKeeper capabilitykeeper.ScopedKeeper
 
 	GuardianKeeper    guardiankeeper.Keeper
+	TxFeesKeeper      txfeeskeeper.Keeper
+	GlobalFeeKeeper   globalfeekeeper.Keeper
+	MsgFilterKeeper   msgfilterkeeper.Keeper
 	TokenKeeper       tokenkeeper.Keeper
 	RecordKeeper      recordkeeper.Keeper
 	NFTKeeper         nftkeeper.Keeper
@@ -372,7 +387,7 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
-		guardiantypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
+		guardiantypes.StoreKey, txfeestypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
 		coinswaptypes.StoreKey, servicetypes.StoreKey, oracletypes.StoreKey, randomtypes.StoreKey,
 		farmtypes.StoreKey, feegrant.StoreKey, tibchost.StoreKey, tibcnfttypes.StoreKey, tibcmttypes.StoreKey, mttypes.StoreKey,
 	)
@@ -434,12 +449,12 @@ func NewSimApp(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
 
-	app.MintKeeper = mintkeeper.NewKeeper(
+	app.TokenKeeper = tokenkeeper.NewKeeper(
 		appCodec,
-		keys[minttypes.StoreKey],
-		app.GetSubspace(minttypes.ModuleName),
-		app.AccountKeeper,
+		keys[tokentypes.StoreKey],
+		app.GetSubspace(tokentypes.ModuleName),
 		app.BankKeeper,
+		app.ModuleAccountAddrs(),
 		authtypes.FeeCollectorName,
 	)
 
@@ -453,6 +468,19 @@ func NewSimApp(
 		authtypes.FeeCollectorName,
 	)
 
+	app.MintKeeper = mintkeeper.NewKeeper(
+		appCodec,
+		keys[minttypes.StoreKey],
+		app.GetSubspace(minttypes.ModuleName),
+		&stakingKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.TokenKeeper,
+		app.DistrKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec,
 		keys[slashingtypes.StoreKey],
@@ -573,15 +601,8 @@ func NewSimApp(
 	app.GuardianKeeper = guardiankeeper.NewKeeper(
 		appCodec,
 		keys[guardiantypes.StoreKey],
-	)
-
-	app.TokenKeeper = tokenkeeper.NewKeeper(
-		appCodec,
-		keys[tokentypes.StoreKey],
-		app.GetSubspace(tokentypes.ModuleName),
-		app.BankKeeper,
-		app.ModuleAccountAddrs(),
-		authtypes.FeeCollectorName,
+		app.GetSubspace(guardiantypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.RecordKeeper = recordkeeper.NewKeeper(
@@ -607,6 +628,19 @@ func NewSimApp(
 		authtypes.FeeCollectorName,
 	)
 
+	app.TxFeesKeeper = txfeeskeeper.NewKeeper(
+		appCodec,
+		keys[txfeestypes.StoreKey],
+		app.GetSubspace(txfeestypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.CoinswapKeeper,
+		authtypes.FeeCollectorName,
+	)
+
+	app.GlobalFeeKeeper = globalfeekeeper.NewKeeper(app.GetSubspace(globalfeetypes.ModuleName))
+	app.MsgFilterKeeper = msgfilterkeeper.NewKeeper(app.GetSubspace(msgfiltertypes.ModuleName))
+
 	app.ServiceKeeper = servicekeeper.NewKeeper(
 		appCodec,
 		keys[servicetypes.StoreKey],
@@ -645,7 +679,7 @@ func NewSimApp(
 
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, mint.NewParamChangeProposalHandler(app.MintKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
@@ -702,7 +736,7 @@ func NewSimApp(
 		transferModule,
 		nfttransferModule,
 		mttransferModule,
-		guardian.NewAppModule(appCodec, app.GuardianKeeper),
+		guardian.NewAppModule(appCodec, app.GuardianKeeper, app.AccountKeeper, app.BankKeeper),
 		token.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
 		record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper),
@@ -713,6 +747,9 @@ func NewSimApp(
 		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
 		random.NewAppModule(appCodec, app.RandomKeeper, app.AccountKeeper, app.BankKeeper),
 		farm.NewAppModule(appCodec, app.FarmKeeper, app.AccountKeeper, app.BankKeeper),
+		txfees.NewAppModule(appCodec, app.TxFeesKeeper),
+		globalfee.NewAppModule(appCodec, app.GlobalFeeKeeper),
+		msgfilter.NewAppModule(appCodec, app.MsgFilterKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -755,6 +792,9 @@ func NewSimApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txfeestypes.ModuleName,
+		globalfeetypes.ModuleName,
+		msgfiltertypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		//sdk module
@@ -792,6 +832,9 @@ func NewSimApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txfeestypes.ModuleName,
+		globalfeetypes.ModuleName,
+		msgfiltertypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -809,8 +852,6 @@ func NewSimApp(
 		stakingtypes.ModuleName,
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
-		minttypes.ModuleName,
-		crisistypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		feegrant.ModuleName,
@@ -821,6 +862,8 @@ func NewSimApp(
 
 		//self module
 		tokentypes.ModuleName,
+		// mint validates the tokens of its denom inflations
+		minttypes.ModuleName,
 		tibchost.ModuleName,
 		ibctransfertypes.ModuleName,
 		nfttypes.ModuleName,
@@ -835,6 +878,12 @@ func NewSimApp(
 		tibcnfttypes.ModuleName,
 		tibcmttypes.ModuleName,
 		guardiantypes.ModuleName,
+		txfeestypes.ModuleName,
+		globalfeetypes.ModuleName,
+		msgfiltertypes.ModuleName,
+
+		// crisis needs to be last so that the invariants are asserted on the whole genesis state
+		crisistypes.ModuleName,
 	)
 
 	cfg := module.NewConfigurator(appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -862,7 +911,7 @@ func NewSimApp(
 		transferModule,
 		nfttransferModule,
 		mttransferModule,
-		guardian.NewAppModule(appCodec, app.GuardianKeeper),
+		guardian.NewAppModule(appCodec, app.GuardianKeeper, app.AccountKeeper, app.BankKeeper),
 		token.NewAppModule(appCodec, app.TokenKeeper, app.AccountKeeper, app.BankKeeper),
 		record.NewAppModule(appCodec, app.RecordKeeper, app.AccountKeeper, app.BankKeeper),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper),
@@ -1084,6 +1133,10 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govv1.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
+	paramsKeeper.Subspace(guardiantypes.ModuleName)
+	paramsKeeper.Subspace(txfeestypes.ModuleName)
+	paramsKeeper.Subspace(globalfeetypes.ModuleName)
+	paramsKeeper.Subspace(msgfiltertypes.ModuleName)
 	paramsKeeper.Subspace(tokentypes.ModuleName)
 	paramsKeeper.Subspace(recordtypes.ModuleName)
 	paramsKeeper.Subspace(htlctypes.ModuleName)
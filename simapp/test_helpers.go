@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -33,6 +34,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 	minttypes "github.com/irisnet/irishub/modules/mint/types"
 )
 
@@ -99,9 +101,27 @@ func NewConfig() network.Config {
 	cfg.InterfaceRegistry = encCfg.InterfaceRegistry
 	cfg.AppConstructor = SimAppConstructor
 	cfg.GenesisState = NewDefaultGenesisState(cfg.Codec)
+
+	// the guardian genesis state requires at least one genesis super
+	genesisSuper := sdk.AccAddress(tmcrypto.AddressHash([]byte("genesis-super")))
+	cfg.GenesisState[guardiantypes.ModuleName] = cfg.Codec.MustMarshalJSON(
+		guardianGenesisWithSuper(cfg.Codec, cfg.GenesisState, genesisSuper),
+	)
 	return cfg
 }
 
+// guardianGenesisWithSuper returns the guardian genesis state with the address
+// added as a genesis super
+func guardianGenesisWithSuper(cdc codec.JSONCodec, genesisState GenesisState, addr sdk.AccAddress) *guardiantypes.GenesisState {
+	var guardianGenesis guardiantypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[guardiantypes.ModuleName], &guardianGenesis)
+	guardianGenesis.Supers = append(
+		guardianGenesis.Supers,
+		guardiantypes.NewSuper("genesis", guardiantypes.Genesis, addr, addr),
+	)
+	return &guardianGenesis
+}
+
 func SimAppConstructor(val network.Validator) servertypes.Application {
 	return NewSimApp(
 		val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool),
@@ -212,6 +232,11 @@ func GenesisStateWithValSet(codec codec.Codec, genesisState map[string]json.RawM
 	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{})
 	genesisState[banktypes.ModuleName] = codec.MustMarshalJSON(bankGenesis)
 
+	// set the first genesis account as the genesis super
+	genesisState[guardiantypes.ModuleName] = codec.MustMarshalJSON(
+		guardianGenesisWithSuper(codec, genesisState, genAccs[0].GetAddress()),
+	)
+
 	return genesisState, nil
 }
 